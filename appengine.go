@@ -0,0 +1,363 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The cron.yaml of App Engine and Cloud Scheduler writes schedules in English-like
+// specs of two kinds:
+//
+//	every N hours|mins [from HH:MM to HH:MM | synchronized]
+//	("every"|ORDINALS) (DAYS|"day") [of ("month"|MONTHS)] HH:MM
+//
+// i.e. "every 5 minutes from 10:00 to 14:00" or "1st,3rd mon,wed,thu of sep,oct,nov 17:00",
+// along with days of the month such as "1 of jan,april,july,oct 00:00". A spec converts to
+// one expression or more of a dialect: the runs of an interval from a start time fall on
+// different minutes from an hour to the other, each set of minutes takes an expression.
+// An interval without a start follows the end of the previous run, which cron cannot,
+// it converts to the closest expression and is marked approximate.
+
+// maxAppEngineExpressions caps the expressions a spec converts to
+const maxAppEngineExpressions = 4
+
+var (
+	appEngineTimeRegexp    = regexp.MustCompile(`^([01]?\d|2[0-3]):([0-5]\d)$`)
+	appEngineOrdinalRegexp = regexp.MustCompile(`^([1-5])(?:st|nd|rd|th)$`)
+
+	appEngineOrdinalList = map[string]int{
+		"first":  1,
+		"second": 2,
+		"third":  3,
+		"fourth": 4,
+		"fifth":  5,
+	}
+
+	appEngineUnitList = map[string]int{
+		"minute":  1,
+		"minutes": 1,
+		"min":     1,
+		"mins":    1,
+		"hour":    60,
+		"hours":   60,
+		"hr":      60,
+		"hrs":     60,
+	}
+)
+
+// AppEngineSchedule is a spec of cron.yaml converted to cron, Approximate when the
+// expressions only come close to it
+type AppEngineSchedule struct {
+	Spec        string
+	Expressions []string
+	Approximate bool
+}
+
+// ConversionError is a spec of cron.yaml that parses but has no equivalent in the dialect
+type ConversionError struct {
+	Spec   string
+	Reason string
+}
+
+func (self *ConversionError) Error() string {
+	return fmt.Sprintf("%q cannot be converted: %s", self.Spec, self.Reason)
+}
+
+// ConvertAppEngine converts a spec of cron.yaml into expressions of the dialect. The error
+// is a ConversionError when the spec parses but cannot be converted.
+func ConvertAppEngine(spec string, dialect int) (*AppEngineSchedule, error) {
+	wordList := strings.Fields(strings.ToLower(strings.Replace(spec, ", ", ",", -1)))
+	if len(wordList) == 0 {
+		return nil, errors.New("schedule is empty")
+	}
+	writer := &scheduleCompiler{dialect: dialect}
+	if _, err := strconv.Atoi(wordList[1%len(wordList)]); wordList[0] == "every" && err == nil &&
+		len(wordList) > 2 && appEngineUnitList[wordList[2]] != 0 {
+		return convertAppEngineInterval(spec, wordList, writer)
+	}
+	return convertAppEngineDays(spec, wordList, writer)
+}
+
+// convertAppEngineInterval converts "every N hours|mins [from HH:MM to HH:MM | synchronized]"
+func convertAppEngineInterval(spec string, wordList []string, writer *scheduleCompiler) (*AppEngineSchedule, error) {
+	count, err := strconv.Atoi(wordList[1])
+	if err != nil || count < 1 {
+		return nil, fmt.Errorf("invalid interval %q", wordList[1])
+	}
+	interval := count * appEngineUnitList[wordList[2]]
+	schedule := &AppEngineSchedule{Spec: spec}
+
+	//a synchronized interval runs from midnight to midnight
+	from, to := 0, 24*60-1
+	switch rest := wordList[3:]; {
+	case len(rest) == 0:
+		schedule.Approximate = true
+	case len(rest) == 1 && rest[0] == "synchronized":
+		if interval > 24*60 || 24*60%interval != 0 {
+			return nil, fmt.Errorf("a synchronized interval divides a day, %d minutes do not", interval)
+		}
+	case len(rest) == 4 && rest[0] == "from" && rest[2] == "to":
+		if from, err = parseAppEngineTime(rest[1]); err != nil {
+			return nil, err
+		}
+		if to, err = parseAppEngineTime(rest[3]); err != nil {
+			return nil, err
+		}
+		if to < from {
+			return nil, &ConversionError{Spec: spec, Reason: "the interval runs past midnight"}
+		}
+	default:
+		return nil, fmt.Errorf("unexpected %q", strings.Join(rest, " "))
+	}
+
+	if schedule.Approximate {
+		minutes, hours := "0", ""
+		switch {
+		case interval < 60:
+			minutes, hours = "*/"+strconv.Itoa(interval), "*"
+		case interval%60 == 0 && interval < 24*60:
+			hours = "*/" + strconv.Itoa(interval/60)
+		case interval == 24*60:
+			hours = "0"
+		default:
+			return nil, &ConversionError{Spec: spec, Reason: fmt.Sprintf("an interval of %d minutes does not fit in a day", interval)}
+		}
+		expression, err := writer.writeExpression("0", minutes, hours, "", "", "")
+		if err != nil {
+			return nil, &ConversionError{Spec: spec, Reason: err.Error()}
+		}
+		schedule.Expressions = []string{expression}
+		return schedule, nil
+	}
+
+	//the runs of each hour, then an expression for each set of minutes
+	minuteSetList := make(map[int][]int)
+	for run := from; run <= to; run += interval {
+		minuteSetList[run/60] = append(minuteSetList[run/60], run%60)
+	}
+	hourListBySet := make(map[string][]int)
+	minutesBySet := make(map[string][]int)
+	setList := make([]string, 0)
+	for hour := 0; hour < 24; hour++ {
+		minuteList, ok := minuteSetList[hour]
+		if !ok {
+			continue
+		}
+		key := joinInts(minuteList)
+		if _, ok := hourListBySet[key]; !ok {
+			setList = append(setList, key)
+			minutesBySet[key] = minuteList
+		}
+		hourListBySet[key] = append(hourListBySet[key], hour)
+	}
+	if len(setList) > maxAppEngineExpressions {
+		return nil, &ConversionError{Spec: spec, Reason: fmt.Sprintf("it takes %d expressions", len(setList))}
+	}
+
+	for _, key := range setList {
+		expression, err := writer.writeExpression("0", compactValues(minutesBySet[key], 0, 59), compactValues(hourListBySet[key], 0, 23), "", "", "")
+		if err != nil {
+			return nil, &ConversionError{Spec: spec, Reason: err.Error()}
+		}
+		schedule.Expressions = append(schedule.Expressions, expression)
+	}
+	return schedule, nil
+}
+
+// convertAppEngineDays converts "("every"|ORDINALS) (DAYS|"day") [of ("month"|MONTHS)] HH:MM"
+// and "DAYS_OF_MONTH of ("month"|MONTHS) HH:MM"
+func convertAppEngineDays(spec string, wordList []string, writer *scheduleCompiler) (*AppEngineSchedule, error) {
+	minutes, err := parseAppEngineTime(wordList[len(wordList)-1])
+	if err != nil {
+		return nil, err
+	}
+	wordList = wordList[:len(wordList)-1]
+
+	month := ""
+	if len(wordList) >= 3 && wordList[len(wordList)-2] == "of" {
+		if month, err = parseAppEngineMonths(wordList[len(wordList)-1]); err != nil {
+			return nil, err
+		}
+		wordList = wordList[:len(wordList)-2]
+	}
+
+	dayOfMonth, dayOfWeek := "", ""
+	switch {
+	case len(wordList) == 1:
+		//days of the month, i.e. "1,15 of month 00:00"
+		itemList := make([]string, 0)
+		for _, item := range strings.Split(wordList[0], ",") {
+			day, err := strconv.Atoi(item)
+			if err != nil || day < 1 || day > 31 {
+				return nil, fmt.Errorf("invalid day of the month %q", item)
+			}
+			itemList = append(itemList, item)
+		}
+		dayOfMonth = strings.Join(itemList, ",")
+	case len(wordList) == 2:
+		ordinalList, err := parseAppEngineOrdinals(wordList[0])
+		if err != nil {
+			return nil, err
+		}
+		dayList, err := parseAppEngineDays(wordList[1])
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case ordinalList == nil && dayList == nil:
+		case ordinalList == nil:
+			dayOfWeek = strings.Join(dayList, ",")
+		case dayList == nil:
+			//the nth days of the month
+			dayOfMonth = joinInts(ordinalList)
+		default:
+			itemList := make([]string, 0)
+			for _, day := range dayList {
+				for _, ordinal := range ordinalList {
+					itemList = append(itemList, day+"#"+strconv.Itoa(ordinal))
+				}
+			}
+			dayOfWeek = strings.Join(itemList, ",")
+			writer.withSpecialDays = true
+			if writer.dialect == DialectQuartz && len(itemList) > 1 {
+				return nil, &ConversionError{Spec: spec, Reason: "quartz takes a single nth day of the week"}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unexpected %q", strings.Join(wordList, " "))
+	}
+
+	expression, err := writer.writeExpression("0", strconv.Itoa(minutes%60), strconv.Itoa(minutes/60), dayOfMonth, month, dayOfWeek)
+	if err != nil {
+		return nil, &ConversionError{Spec: spec, Reason: err.Error()}
+	}
+	return &AppEngineSchedule{Spec: spec, Expressions: []string{expression}}, nil
+}
+
+// parseAppEngineTime returns a time HH:MM in minutes past midnight
+func parseAppEngineTime(text string) (int, error) {
+	match := appEngineTimeRegexp.FindStringSubmatch(text)
+	if match == nil {
+		return 0, fmt.Errorf("invalid time %q", text)
+	}
+	hour, _ := strconv.Atoi(match[1])
+	minute, _ := strconv.Atoi(match[2])
+	return hour*60 + minute, nil
+}
+
+// parseAppEngineOrdinals returns the ordinals of "1st,third", nil for "every"
+func parseAppEngineOrdinals(text string) ([]int, error) {
+	if text == "every" {
+		return nil, nil
+	}
+	ordinalList := make([]int, 0)
+	for _, item := range strings.Split(text, ",") {
+		ordinal, ok := appEngineOrdinalList[item]
+		if match := appEngineOrdinalRegexp.FindStringSubmatch(item); match != nil {
+			ordinal, _ = strconv.Atoi(match[1])
+			ok = true
+		}
+		if !ok {
+			return nil, fmt.Errorf("invalid ordinal %q", item)
+		}
+		ordinalList = append(ordinalList, ordinal)
+	}
+	return ordinalList, nil
+}
+
+// parseAppEngineDays returns the names of the days of "mon,wednesday", nil for "day"
+func parseAppEngineDays(text string) ([]string, error) {
+	if text == "day" {
+		return nil, nil
+	}
+	dayList := make([]string, 0)
+	for _, item := range strings.Split(text, ",") {
+		day, ok := scheduleDayList[item]
+		if !ok {
+			return nil, fmt.Errorf("invalid day %q", item)
+		}
+		dayList = append(dayList, CronDays[day])
+	}
+	return dayList, nil
+}
+
+// parseAppEngineMonths returns the month field of "month" or "jan,april"
+func parseAppEngineMonths(text string) (string, error) {
+	if text == "month" {
+		return "", nil
+	}
+	monthList := make([]int, 0)
+	for _, item := range strings.Split(text, ",") {
+		month, ok := scheduleMonthList[item]
+		if !ok {
+			return "", fmt.Errorf("invalid month %q", item)
+		}
+		monthList = append(monthList, month)
+	}
+	sort.Ints(monthList)
+	return joinInts(monthList), nil
+}
+
+func joinInts(valueList []int) string {
+	itemList := make([]string, 0)
+	for _, value := range valueList {
+		itemList = append(itemList, strconv.Itoa(value))
+	}
+	return strings.Join(itemList, ",")
+}
+
+// compactValues writes ordered values of a field in the shortest way, i.e. */5 or 8-20/2
+// rather than listing them
+func compactValues(valueList []int, min, max int) string {
+	if len(valueList) == 1 {
+		return strconv.Itoa(valueList[0])
+	}
+	step := valueList[1] - valueList[0]
+	for i := 2; i < len(valueList); i++ {
+		if valueList[i]-valueList[i-1] != step {
+			return joinInts(valueList)
+		}
+	}
+	first, last := valueList[0], valueList[len(valueList)-1]
+	switch {
+	case first == min && last+step > max && step == 1:
+		return "*"
+	case first == min && last+step > max:
+		return "*/" + strconv.Itoa(step)
+	case step == 1:
+		return strconv.Itoa(first) + "-" + strconv.Itoa(last)
+	default:
+		return strconv.Itoa(first) + "-" + strconv.Itoa(last) + "/" + strconv.Itoa(step)
+	}
+}
+
+// DescribeAppEngine describes each expression the cron.yaml spec of the descriptor converts
+// to, in the dialect of the options
+func (self *descriptor) DescribeAppEngine() ([]string, error) {
+	dialect := self.Options.Dialect
+	if dialect == unuseDialect {
+		//a spec has no fields to tell the dialect by
+		dialect = DialectAnd
+	}
+	schedule, err := ConvertAppEngine(self.Expression, dialect)
+	if err != nil {
+		return nil, err
+	}
+	return self.describeAppEngine(schedule), nil
+}
+
+func (self *descriptor) describeAppEngine(schedule *AppEngineSchedule) []string {
+	descriptionList := make([]string, 0)
+	for _, expression := range schedule.Expressions {
+		description := NewDescriptor(expression, self.Options).GetDescription()
+		if schedule.Approximate {
+			description += self.Printer.Sprintf(" (approximately)")
+		}
+		descriptionList = append(descriptionList, description)
+	}
+	return descriptionList
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bufio"
+	"cron-descriptor/locale"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// The appengine command converts the schedules of App Engine and Cloud Scheduler (see
+// appengine.go), one given as its arguments or those of the schedule keys of a cron.yaml:
+//
+//	cron-descriptor appengine -file cron.yaml
+//
+// Each schedule is followed by its expressions and their descriptions, or by why it cannot
+// be converted. The exit code is 1 when a schedule cannot be converted.
+
+const appEngineScheduleKey = "schedule:"
+
+// appEngineTarget is a schedule to convert and where it was found, Line is 0 when it was
+// given as arguments
+type appEngineTarget struct {
+	File string
+	Line int
+	Spec string
+}
+
+// runAppEngine runs the appengine command and returns its exit code
+func runAppEngine(args []string, stdout, stderr io.Writer) int {
+	flagSet := flag.NewFlagSet("cron-descriptor appengine", flag.ContinueOnError)
+	flagSet.SetOutput(stderr)
+	dialectName := flagSet.String("dialect", "and", "cron dialect: and, vixie or quartz")
+	localeName := flagSet.String("locale", "en_US", "locale of the descriptions, i.e. de_DE")
+	fileName := flagSet.String("file", "", "cron.yaml to read the schedules of")
+	if err := flagSet.Parse(args); err != nil {
+		return 2
+	}
+
+	dialect, ok := dialectNameList[*dialectName]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown dialect %q\n", *dialectName)
+		return 2
+	}
+	localeType, ok := locale.Lookup(*localeName)
+	if !ok {
+		fmt.Fprintf(stderr, "unknown locale %q\n", *localeName)
+		return 2
+	}
+
+	var targetList []appEngineTarget
+	if *fileName != "" {
+		file, err := os.Open(*fileName)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+		targetList, err = readAppEngineTargets(*fileName, file)
+		file.Close()
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+	} else if spec := strings.Join(flagSet.Args(), " "); spec != "" {
+		targetList = []appEngineTarget{{Spec: spec}}
+	} else {
+		fmt.Fprintln(stderr, "usage: cron-descriptor appengine [flags] schedule")
+		flagSet.PrintDefaults()
+		return 2
+	}
+
+	opts := NewDefaultOptions()
+	opts.Language = localeType
+	opts.Dialect = dialect
+
+	code := 0
+	for _, target := range targetList {
+		if target.Line > 0 {
+			fmt.Fprintf(stdout, "%s:%d: %s\n", target.File, target.Line, target.Spec)
+		} else {
+			fmt.Fprintln(stdout, target.Spec)
+		}
+		schedule, err := ConvertAppEngine(target.Spec, dialect)
+		if err != nil {
+			if conversionErr, ok := err.(*ConversionError); ok {
+				fmt.Fprintf(stdout, "\tcannot be converted: %s\n", conversionErr.Reason)
+			} else {
+				fmt.Fprintf(stdout, "\tinvalid schedule: %s\n", err)
+			}
+			code = 1
+			continue
+		}
+		descriptionList := NewDescriptor(target.Spec, opts).describeAppEngine(schedule)
+		for i, expression := range schedule.Expressions {
+			fmt.Fprintf(stdout, "\t%s\t%s\n", expression, descriptionList[i])
+		}
+	}
+	return code
+}
+
+// readAppEngineTargets reads the values of the schedule keys of a cron.yaml
+func readAppEngineTargets(fileName string, r io.Reader) ([]appEngineTarget, error) {
+	targetList := make([]appEngineTarget, 0)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(scanner.Text()), "-"))
+		if !strings.HasPrefix(text, appEngineScheduleKey) {
+			continue
+		}
+		spec := strings.TrimSpace(strings.TrimPrefix(text, appEngineScheduleKey))
+		if unquoted, err := strconv.Unquote(spec); err == nil {
+			spec = unquoted
+		} else if len(spec) >= 2 && spec[0] == '\'' && spec[len(spec)-1] == '\'' {
+			spec = spec[1 : len(spec)-1]
+		} else if i := strings.Index(spec, " #"); i >= 0 {
+			spec = strings.TrimSpace(spec[:i])
+		}
+		targetList = append(targetList, appEngineTarget{File: fileName, Line: line, Spec: spec})
+	}
+	return targetList, scanner.Err()
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestConvertAppEngine(t *testing.T) {
+	testList := []struct {
+		dialect     int
+		spec        string
+		expected    []string
+		approximate bool
+	}{
+		{DialectAnd, "every 5 minutes from 10:00 to 14:00", []string{"*/5 10-13 * * *", "0 14 * * *"}, false},
+		{DialectAnd, "every 5 minutes from 10:00 to 13:55", []string{"*/5 10-13 * * *"}, false},
+		{DialectAnd, "every 90 minutes from 10:00 to 16:00", []string{"0 10-16/3 * * *", "30 11-14/3 * * *"}, false},
+		{DialectAnd, "every 2 hours from 08:00 to 20:00", []string{"0 8-20/2 * * *"}, false},
+		{DialectAnd, "every 2 hours synchronized", []string{"0 */2 * * *"}, false},
+		{DialectAnd, "every 5 minutes", []string{"*/5 * * * *"}, true},
+		{DialectAnd, "every 24 hours", []string{"0 0 * * *"}, true},
+		{DialectAnd, "every day 00:00", []string{"0 0 * * *"}, false},
+		{DialectAnd, "every monday 09:00", []string{"0 9 * * MON"}, false},
+		{DialectAnd, "every mon,tue,wed,thu,fri 09:00", []string{"0 9 * * MON,TUE,WED,THU,FRI"}, false},
+		{DialectAnd, "1st,3rd mon,wed of sep,oct,nov 17:00", []string{"0 17 * 9,10,11 MON#1,MON#3,WED#1,WED#3"}, false},
+		{DialectAnd, "1 of jan,april,july,oct 00:00", []string{"0 0 1 1,4,7,10 *"}, false},
+		{DialectAnd, "second day of month 10:00", []string{"0 10 2 * *"}, false},
+		{DialectQuartz, "first monday of sep 09:00", []string{"0 0 9 ? 9 MON#1"}, false},
+		{DialectQuartz, "every 15 mins from 00:00 to 23:45", []string{"0 */15 * * * ?"}, false},
+		{DialectVixie, "every day 06:30", []string{"30 6 * * *"}, false},
+	}
+
+	for _, val := range testList {
+		schedule, err := ConvertAppEngine(val.spec, val.dialect)
+		if err != nil {
+			t.Errorf("%s: %v", val.spec, err)
+			continue
+		}
+		if !reflect.DeepEqual(schedule.Expressions, val.expected) || schedule.Approximate != val.approximate {
+			t.Errorf("%s: expected %q (%t), got %q (%t)", val.spec, val.expected, val.approximate, schedule.Expressions, schedule.Approximate)
+		}
+	}
+}
+
+func TestConvertAppEngine_error(t *testing.T) {
+	testList := []struct {
+		dialect    int
+		spec       string
+		conversion bool
+	}{
+		{DialectAnd, "", false},
+		{DialectAnd, "every foo", false},
+		{DialectAnd, "every 7 minutes synchronized", false},
+		{DialectAnd, "every monday 25:00", false},
+		{DialectAnd, "6th monday of month 09:00", false},
+		{DialectAnd, "every 30 mins from 22:00 to 02:00", true},
+		{DialectAnd, "every 7 minutes from 00:00 to 23:59", true},
+		{DialectAnd, "every 36 hours", true},
+		{DialectVixie, "1st monday of sep 09:00", true},
+		{DialectQuartz, "1st,3rd monday of sep 09:00", true},
+	}
+
+	for _, val := range testList {
+		_, err := ConvertAppEngine(val.spec, val.dialect)
+		if err == nil {
+			t.Errorf("%s: expected an error", val.spec)
+			continue
+		}
+		if _, ok := err.(*ConversionError); ok != val.conversion {
+			t.Errorf("%s: expected a conversion error %t, got %v", val.spec, val.conversion, err)
+		}
+	}
+}
+
+func TestDescriptor_DescribeAppEngine(t *testing.T) {
+	testList := []struct {
+		language int
+		spec     string
+		expected string
+	}{
+		{locale.EN_US, "every 5 minutes from 10:00 to 14:00", "Every 5 minutes, between 10:00 AM and 01:55 PM|At 02:00 PM"},
+		{locale.EN_US, "every 3 hours", "Every 3 hours (approximately)"},
+		{locale.EN_US, "1st,3rd mon,wed,thu of sep,oct,nov 17:00",
+			"At 05:00 PM, on the 1st and 3rd Monday, Wednesday, and Thursday of the month, only in September, October, and November"},
+		{locale.DE_DE, "every 5 minutes", "Alle 5 Minuten (ungefähr)"},
+		{locale.FR_FR, "every monday 09:00", "À 09:00 AM, uniquement les lundis"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		descriptionList, err := NewDescriptor(val.spec, opts).DescribeAppEngine()
+		if err != nil {
+			t.Errorf("%s: %v", val.spec, err)
+			continue
+		}
+		if description := strings.Join(descriptionList, "|"); description != val.expected {
+			t.Errorf("%s (%d): expected %q, got %q", val.spec, val.language, val.expected, description)
+		}
+	}
+}
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	//at most this many values or ranges of values are listed in a short description
+	maxShortRunCount = 6
+	//at most this many times of day are listed in a short description
+	maxShortTimeListLength = 4
+)
+
+var (
+	shortNearestWeekdayRegexp = regexp.MustCompile(`^(?:(\d{1,2})W|W(\d{1,2}))$`)
+	shortLastDayRegexp        = regexp.MustCompile(`^(\d)L$`)
+	shortNthDayRegexp         = regexp.MustCompile(`^(\d)#([1-5])$`)
+)
+
+// isShortStyle reports whether the description is abbreviated, speakable descriptions never are
+func (self *descriptor) isShortStyle() bool {
+	if self.Options.Speakable {
+		return false
+	}
+	return self.Options.DescriptionStyle == StyleShort || self.Options.DescriptionStyle == StyleCompact
+}
+
+// getShortSegments describes the expression in a few tokens with the days before the time
+// of day, i.e. "Mon–Fri 10:15". StyleShort separates the tokens with the clause separator
+// of the locale and StyleCompact with a space. Fields without a short form are described
+// as in the long description.
+func (self *descriptor) getShortSegments(entity *cronEntity) ([]Segment, error) {
+	timeSegment, err := self.getShortTimeOfDaySegment(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	separator := " "
+	if self.Options.DescriptionStyle == StyleShort {
+		separator = locale.ClauseSeparator(self.Options.Language)
+	}
+
+	dayOfWeekSegment := newFieldSegment(entity, FieldDayOfWeek, self.getShortDayOfWeekDescription(entity))
+	dayOfMonthSegment := newFieldSegment(entity, FieldDayOfMonth, self.getShortDayOfMonthDescription(entity))
+	if daysEitherMatch(entity, self.Options) {
+		dayOfWeekSegment, dayOfMonthSegment = self.joinEitherDays(entity, dayOfMonthSegment, dayOfWeekSegment), Segment{}
+	}
+
+	segments := make([]Segment, 0)
+	for _, segment := range []Segment{
+		dayOfWeekSegment,
+		dayOfMonthSegment,
+		newFieldSegment(entity, FieldMonth, self.getShortMonthDescription(entity)),
+		newFieldSegment(entity, FieldYear, self.getShortYearDescription(entity)),
+		timeSegment,
+	} {
+		if segment.Text == "" {
+			continue
+		}
+		if len(segments) > 0 {
+			segment.Text = separator + segment.Text
+		}
+		segments = append(segments, segment)
+	}
+
+	return transformSegments(segments, func(description string) string {
+		description = self.resolveNames(description, locale.Nominative)
+		return self.transformCase(description)
+	}), nil
+}
+
+func (self *descriptor) getShortTimeOfDaySegment(entity *cronEntity) (Segment, error) {
+	fields := []int{FieldSeconds, FieldMinutes, FieldHours}
+	if description, kind, ok := self.getShortTimeOfDayDescription(entity); ok {
+		return newSegment(entity, kind, description, fields...), nil
+	}
+
+	segments, err := self.getTimeOfDaySegments(entity)
+	if err != nil || len(segments) == 0 {
+		return Segment{}, err
+	}
+	return newSegment(entity, segments[0].Kind, joinSegments(segments, ""), fields...), nil
+}
+
+// getShortTimeOfDayDescription lists the times of day, or gives how often the schedule
+// runs within the hour and the windows of hours it runs in. ok is false for the shapes
+// a few tokens would misdescribe.
+func (self *descriptor) getShortTimeOfDayDescription(entity *cronEntity) (string, int, bool) {
+	secondList, secondsOk := expandField(entity.Seconds, 0, 59)
+	minuteList, minutesOk := expandField(entity.Minutes, 0, 59)
+	hourList, hoursOk := expandField(entity.Hours, 0, 23)
+	if !secondsOk || !minutesOk || !hoursOk {
+		return "", 0, false
+	}
+	hoursExp := entity.Hours
+	rangeSeparator := locale.RangeSeparator(self.Options.Language)
+
+	formatTimeOfDay := func(hour, minute, second int) string {
+		secondExp := ""
+		if entity.Seconds != "" {
+			secondExp = strconv.Itoa(second)
+		}
+		timeStr, _ := self.formatTime(strconv.Itoa(hour), strconv.Itoa(minute), secondExp)
+		return timeStr
+	}
+	formatWindow := func(firstHour, lastHour int) string {
+		first := formatTimeOfDay(firstHour, minuteList[0], secondList[0])
+		last := formatTimeOfDay(lastHour, minuteList[len(minuteList)-1], secondList[len(secondList)-1])
+		return first + rangeSeparator + last
+	}
+
+	isOncePerHour := len(secondList) == 1 && len(minuteList) == 1
+	if entity.Seconds == "" && len(hourList) == 1 && len(minuteList) > 1 && len(valueRuns(minuteList)) == 1 {
+		//minute range in single hour (i.e. 0-10 11)
+		return self.Printer.Sprintf("every minute") + " " + formatWindow(hourList[0], hourList[0]), SegmentRange, true
+
+	} else if len(secondList)*len(minuteList)*len(hourList) <= maxShortTimeListLength ||
+		isOncePerHour && !strings.ContainsAny(hoursExp, "*-/") {
+
+		timeList := make([]string, 0)
+		for _, hour := range hourList {
+			for _, minute := range minuteList {
+				for _, second := range secondList {
+					timeList = append(timeList, formatTimeOfDay(hour, minute, second))
+				}
+			}
+		}
+		if len(timeList) == 1 {
+			return timeList[0], SegmentSingle, true
+		}
+		return self.joinShortList(timeList), SegmentList, true
+	}
+
+	windowList := make([]string, 0)
+	for _, run := range valueRuns(hourList) {
+		windowList = append(windowList, formatWindow(run[0], run[1]))
+	}
+
+	if isOncePerHour {
+		if strings.Contains(hoursExp, "/") {
+			every := self.Printer.Sprintf("every %s h", strings.SplitN(hoursExp, "/", 2)[1])
+			return every + " " + formatWindow(hourList[0], hourList[len(hourList)-1]), SegmentInterval, true
+		}
+		if hoursExp == "*" && minuteList[0] == 0 && secondList[0] == 0 {
+			return self.Printer.Sprintf("hourly"), SegmentAll, true
+		}
+		return self.Printer.Sprintf("hourly") + " " + self.joinShortList(windowList), segmentKind(hoursExp), true
+	}
+
+	//the windows only hold when the schedule runs all through each hour of them
+	if entity.Seconds != "" && len(secondList) == 1 {
+		return "", 0, false
+	}
+	every := ""
+	if len(secondList) > 1 {
+		step, ok := coveringStep(secondList, 60)
+		if !ok || len(minuteList) != 60 {
+			return "", 0, false
+		}
+		every = self.Printer.Sprintf("every second")
+		if step > 1 {
+			every = self.Printer.Sprintf("every %s sec", strconv.Itoa(step))
+		}
+	} else {
+		step, ok := coveringStep(minuteList, 60)
+		if !ok {
+			return "", 0, false
+		}
+		every = self.Printer.Sprintf("every minute")
+		if step > 1 {
+			every = self.Printer.Sprintf("every %s min", strconv.Itoa(step))
+		}
+	}
+
+	if hoursExp == "*" {
+		return every, SegmentInterval, true
+	} else if strings.Contains(hoursExp, "/") {
+		everyHours := self.Printer.Sprintf("every %s h", strings.SplitN(hoursExp, "/", 2)[1])
+		return every + " " + everyHours + " " + formatWindow(hourList[0], hourList[len(hourList)-1]), SegmentInterval, true
+	}
+	return every + " " + self.joinShortList(windowList), SegmentInterval, true
+}
+
+func (self *descriptor) getShortDayOfMonthDescription(entity *cronEntity) string {
+	expression := entity.DayOfMonth
+	language := self.Options.Language
+
+	if expression == "" || expression == "*" {
+		return ""
+
+	} else if expression == "L" {
+		return self.Printer.Sprintf("last day")
+
+	} else if expression == "LW" || expression == "WL" {
+		return self.Printer.Sprintf("last weekday")
+
+	} else if match := shortNearestWeekdayRegexp.FindStringSubmatch(expression); match != nil {
+		dayNum, _ := strconv.Atoi(match[1] + match[2])
+		return self.Printer.Sprintf("nearest weekday to the %s", locale.DayOrdinal(language, dayNum))
+
+	} else if strings.HasPrefix(expression, "*/") {
+		return self.Printer.Sprintf("every %s days", strings.TrimPrefix(expression, "*/"))
+
+	} else if parts := strings.SplitN(expression, "/", 2); len(parts) == 2 {
+		if dayNum, err := strconv.Atoi(parts[0]); err == nil {
+			return self.Printer.Sprintf("every %s days from the %s", parts[1], locale.DayOrdinal(language, dayNum))
+		}
+
+	} else if dayList, ok := expandField(expression, 1, 31); ok {
+		if description, ok := self.shortRuns(dayList, func(dayNum int) string {
+			return locale.DayOrdinal(language, dayNum)
+		}); ok {
+			return description
+		}
+	}
+
+	return strings.TrimPrefix(self.getDayOfMonthDescription(entity), locale.ClauseSeparator(language))
+}
+
+func (self *descriptor) getShortDayOfWeekDescription(entity *cronEntity) string {
+	expression := entity.DayOfWeek
+	language := self.Options.Language
+
+	if expression == "" || expression == "*" {
+		return ""
+
+	} else if match := shortLastDayRegexp.FindStringSubmatch(expression); match != nil {
+		dayNumber, _ := strconv.Atoi(match[1])
+		return self.sprintf("last %s", self.dayReference(dayNumber))
+
+	} else if match := shortNthDayRegexp.FindStringSubmatch(expression); match != nil {
+		dayNumber, _ := strconv.Atoi(match[1])
+		num, _ := strconv.Atoi(match[2])
+		if num >= 1 && dayNumber < len(WeekDayName) {
+			ordinal := locale.Ordinal(language, num, locale.DayGender(language, dayNumber))
+			//the ordinal and the day, i.e. "3rd Sat"
+			return self.sprintf("%s %s", ordinal, self.dayReference(dayNumber))
+		}
+
+	} else if dayList, ok := expandField(expression, 0, len(WeekDayName)-1); ok {
+		if description, ok := self.shortRuns(dayList, self.dayReference); ok {
+			return description
+		}
+	}
+
+	return strings.TrimPrefix(self.getDayOfWeekDescription(entity), locale.ClauseSeparator(language))
+}
+
+func (self *descriptor) getShortMonthDescription(entity *cronEntity) string {
+	expression := entity.Month
+	if expression == "" || expression == "*" {
+		return ""
+	}
+
+	if monthList, ok := expandField(expression, 1, 12); ok {
+		if description, ok := self.shortRuns(monthList, self.monthReference); ok {
+			return description
+		}
+	}
+	return strings.TrimPrefix(self.getMonthDescription(entity), locale.ClauseSeparator(self.Options.Language))
+}
+
+func (self *descriptor) getShortYearDescription(entity *cronEntity) string {
+	expression := entity.Year
+	if expression == "" || expression == "*" {
+		return ""
+	}
+
+	if yearList, ok := expandField(expression, 1970, 2099); ok {
+		if description, ok := self.shortRuns(yearList, strconv.Itoa); ok {
+			return description
+		}
+	}
+	return strings.TrimPrefix(self.getYearDescription(entity), locale.ClauseSeparator(self.Options.Language))
+}
+
+// shortRuns writes the runs of consecutive values as ranges, i.e. "Mon–Wed & Fri",
+// ok is false when there are too many of them for a short description
+func (self *descriptor) shortRuns(valueList []int, fnName func(value int) string) (string, bool) {
+	runList := valueRuns(valueList)
+	if len(runList) > maxShortRunCount {
+		return "", false
+	}
+
+	itemList := make([]string, 0)
+	for _, run := range runList {
+		if run[0] == run[1] {
+			itemList = append(itemList, fnName(run[0]))
+		} else {
+			itemList = append(itemList, fnName(run[0])+locale.RangeSeparator(self.Options.Language)+fnName(run[1]))
+		}
+	}
+	return self.joinShortList(itemList), true
+}
+
+// joinShortList joins the items with the list separator of the locale and the last
+// one with an ampersand when the locale writes it
+func (self *descriptor) joinShortList(itemList []string) string {
+	itemListLength := len(itemList)
+	if itemListLength < 2 {
+		return strings.Join(itemList, "")
+	}
+
+	lastSeparator := self.Printer.Sprintf(" and ")
+	if locale.UsesAmpersand(self.Options.Language) {
+		lastSeparator = " & "
+	}
+	listSeparator := locale.ListSeparator(self.Options.Language)
+	return strings.Join(itemList[:itemListLength-1], listSeparator) + lastSeparator + itemList[itemListLength-1]
+}
+
+// valueRuns groups the ordered values into runs of consecutive values, each given by
+// its first and last value
+func valueRuns(valueList []int) [][]int {
+	runList := make([][]int, 0)
+	for _, value := range valueList {
+		if len(runList) > 0 && runList[len(runList)-1][1] == value-1 {
+			runList[len(runList)-1][1] = value
+		} else {
+			runList = append(runList, []int{value, value})
+		}
+	}
+	return runList
+}
+
+// coveringStep returns the step of values evenly spaced all through 0 to size-1,
+// i.e. 5 for 0,5,...,55 in an hour
+func coveringStep(valueList []int, size int) (int, bool) {
+	if len(valueList) < 2 {
+		return 0, false
+	}
+	step := valueList[1] - valueList[0]
+	for i := 2; i < len(valueList); i++ {
+		if valueList[i]-valueList[i-1] != step {
+			return 0, false
+		}
+	}
+	return step, valueList[0] < step && valueList[len(valueList)-1]+step >= size
+}
@@ -0,0 +1,813 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// A schedule written in English compiles to an expression of a cron dialect, the
+// reverse of a description: "every weekday at 9:30am" is "30 9 * * MON-FRI". The
+// grammar is a sequence of clauses in any order, each given at most once:
+//
+//	every [N|other] second|minute|hour|day|week|month|year, every quarter
+//	hourly, daily, weekly, monthly, quarterly, yearly, annually
+//	[every|on] <days>             monday, mondays, weekdays, weekends, tue and fri, mon to fri
+//	[on] the 1st[, 10th and 15th] [day] [of the month], [on] day 1
+//	[on] the first|last day|weekday [of the month]
+//	[on] the first|second|third|fourth|fifth|last <day> [of the month]
+//	at <times>                    9, 9:30am, 9 pm, 18:00, noon, midnight, 8 and 20
+//	at N minutes past the hour
+//	between <time> and <time>, from <time> to <time>
+//	once|twice|thrice|N times a day|an hour
+//	in|from <months>              january, jan and jul, march to october
+//	in <year> [to <year>]
+//
+// A time span ("between 9 and 5") restricts a schedule running every few seconds,
+// minutes or hours, the end hour is left out but for hours. Without a time of day
+// the schedule runs at midnight. Days of the week are written by name, their numbers
+// differ from a dialect to the other.
+
+var (
+	scheduleTimeRegexp = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)?$`)
+	scheduleDayRegexp  = regexp.MustCompile(`^(\d{1,2})(?:st|nd|rd|th)$`)
+	scheduleYearRegexp = regexp.MustCompile(`^\d{4}$`)
+
+	scheduleUnitList = map[string]int{
+		"second": FieldSeconds,
+		"minute": FieldMinutes,
+		"hour":   FieldHours,
+		"day":    FieldDayOfMonth,
+		"week":   FieldDayOfWeek,
+		"month":  FieldMonth,
+		"year":   FieldYear,
+	}
+
+	//the longest step of a unit, weeks and years have none
+	scheduleStepList = map[int]int{
+		FieldSeconds:    59,
+		FieldMinutes:    59,
+		FieldHours:      23,
+		FieldDayOfMonth: 31,
+		FieldMonth:      12,
+	}
+
+	scheduleAdverbList = map[string]int{
+		"hourly":    FieldHours,
+		"daily":     FieldDayOfMonth,
+		"weekly":    FieldDayOfWeek,
+		"monthly":   FieldMonth,
+		"yearly":    FieldYear,
+		"annually":  FieldYear,
+		"quarterly": FieldMonth,
+	}
+
+	scheduleDayList = map[string]int{
+		"sunday": 0, "sun": 0,
+		"monday": 1, "mon": 1,
+		"tuesday": 2, "tue": 2, "tues": 2,
+		"wednesday": 3, "wed": 3,
+		"thursday": 4, "thu": 4, "thur": 4, "thurs": 4,
+		"friday": 5, "fri": 5,
+		"saturday": 6, "sat": 6,
+	}
+
+	scheduleMonthList = map[string]int{
+		"january": 1, "jan": 1,
+		"february": 2, "feb": 2,
+		"march": 3, "mar": 3,
+		"april": 4, "apr": 4,
+		"may":  5,
+		"june": 6, "jun": 6,
+		"july": 7, "jul": 7,
+		"august": 8, "aug": 8,
+		"september": 9, "sep": 9, "sept": 9,
+		"october": 10, "oct": 10,
+		"november": 11, "nov": 11,
+		"december": 12, "dec": 12,
+	}
+
+	scheduleNthList = map[string]int{
+		"first":  1,
+		"second": 2,
+		"third":  3,
+		"fourth": 4,
+		"fifth":  5,
+	}
+
+	scheduleCountList = map[string]int{
+		"once":   1,
+		"twice":  2,
+		"thrice": 3,
+	}
+
+	scheduleRangeWordList = map[string]bool{"to": true, "through": true, "thru": true, "until": true, "till": true, "-": true}
+)
+
+// scheduleTime is a time of day of a schedule, withMeridiem when am or pm was written
+type scheduleTime struct {
+	hour         int
+	minute       int
+	withMeridiem bool
+}
+
+// scheduleCompiler reads the words of a schedule clause by clause into the fields of
+// an expression, empty while no clause sets them
+type scheduleCompiler struct {
+	wordList []string
+	pos      int
+
+	unit, step int
+	//the frequency as written, for errors (i.e. "every 2 hours")
+	frequency    string
+	dayOfMonth   string
+	month        string
+	dayOfWeek    string
+	year         string
+	timeList     []scheduleTime
+	pastMinute   string
+	between      []scheduleTime
+	timesPerDay  int
+	timesPerHour int
+	clauseList   map[string]bool
+	dialect      int
+	//L, W or # are in a day field
+	withSpecialDays bool
+}
+
+// CompileSchedule compiles a schedule written in English (see compile.go for its grammar)
+// into an expression of the dialect
+func CompileSchedule(text string, dialect int) (string, error) {
+	self := &scheduleCompiler{
+		wordList:   splitScheduleWords(text),
+		clauseList: make(map[string]bool),
+		dialect:    dialect,
+	}
+	if len(self.wordList) == 0 {
+		return "", errors.New("schedule is empty")
+	}
+	for self.pos < len(self.wordList) {
+		if err := self.parseClause(); err != nil {
+			return "", err
+		}
+	}
+	return self.expression()
+}
+
+// splitScheduleWords splits a schedule into lowercase words, a comma and a dash are words
+// of their own and a.m. is am
+func splitScheduleWords(text string) []string {
+	text = strings.ToLower(text)
+	text = strings.NewReplacer("a.m.", "am", "p.m.", "pm", ",", " , ", "-", " - ", ".", " ", ";", " ").Replace(text)
+	return strings.Fields(text)
+}
+
+func (self *scheduleCompiler) peek(offset int) string {
+	if self.pos+offset < len(self.wordList) {
+		return self.wordList[self.pos+offset]
+	}
+	return ""
+}
+
+func (self *scheduleCompiler) next() string {
+	word := self.peek(0)
+	self.pos++
+	return word
+}
+
+// accept skips the next word when it is one of the words given
+func (self *scheduleCompiler) accept(wordList ...string) bool {
+	for _, word := range wordList {
+		if self.peek(0) == word {
+			self.pos++
+			return true
+		}
+	}
+	return false
+}
+
+func (self *scheduleCompiler) unexpected() error {
+	if self.pos >= len(self.wordList) {
+		return errors.New("schedule ends too early")
+	}
+	return fmt.Errorf("unexpected %q at word %d", self.wordList[self.pos], self.pos+1)
+}
+
+// once records a clause, which cannot be given twice
+func (self *scheduleCompiler) once(clause string) error {
+	if self.clauseList[clause] {
+		return fmt.Errorf("%s given twice", clause)
+	}
+	self.clauseList[clause] = true
+	return nil
+}
+
+// acceptOfMonth skips "of the month", "of every month" and the like
+func (self *scheduleCompiler) acceptOfMonth() {
+	if self.peek(0) == "of" && (self.peek(1) == "month" || self.peek(2) == "month") {
+		self.pos += 2
+		self.accept("month")
+	}
+}
+
+func (self *scheduleCompiler) parseClause() error {
+	word := self.peek(0)
+	switch {
+	case word == "," || word == "and" || word == "run" || word == "runs":
+		self.pos++
+		return nil
+	case word == "every" || word == "each":
+		self.pos++
+		return self.parseEvery()
+	case scheduleAdverbList[word] != 0:
+		self.pos++
+		if err := self.once("frequency"); err != nil {
+			return err
+		}
+		self.unit, self.step, self.frequency = scheduleAdverbList[word], 1, word
+		if word == "quarterly" {
+			self.step = 3
+		}
+		return nil
+	case word == "on":
+		self.pos++
+		if _, ok := scheduleDayList[strings.TrimSuffix(self.peek(0), "s")]; ok || isWeekdayWord(self.peek(0)) {
+			return self.parseWeekDays()
+		}
+		return self.parseDays()
+	case word == "the" || word == "last" || word == "day" || scheduleNthList[word] != 0:
+		return self.parseDays()
+	case word == "at":
+		self.pos++
+		if _, err := strconv.Atoi(self.peek(0)); err == nil && strings.HasPrefix(self.peek(1), "minute") {
+			return self.parsePastMinute()
+		}
+		return self.parseTimes()
+	case word == "between":
+		self.pos++
+		return self.parseBetween("and")
+	case word == "from" || word == "in" || word == "during":
+		self.pos++
+		if scheduleYearRegexp.MatchString(self.peek(0)) {
+			return self.parseYears()
+		}
+		if _, ok := scheduleMonthList[self.peek(0)]; ok || word != "from" {
+			return self.parseMonths()
+		}
+		return self.parseBetween("to", "until", "till", "-")
+	case scheduleCountList[word] != 0 || self.peek(1) == "times":
+		return self.parseTimesPer()
+	default:
+		if _, ok := scheduleDayList[strings.TrimSuffix(word, "s")]; ok || isWeekdayWord(word) {
+			return self.parseWeekDays()
+		}
+		return self.unexpected()
+	}
+}
+
+// parseEvery reads what follows "every"
+func (self *scheduleCompiler) parseEvery() error {
+	word := self.peek(0)
+	if _, ok := scheduleDayList[word]; ok || isWeekdayWord(word) {
+		return self.parseWeekDays()
+	}
+	if err := self.once("frequency"); err != nil {
+		return err
+	}
+	//from "every" on
+	start := self.pos - 1
+	if self.accept("quarter") {
+		self.unit, self.step = FieldMonth, 3
+		self.frequency = strings.Join(self.wordList[start:self.pos], " ")
+		return nil
+	}
+
+	step := 1
+	if self.accept("other") {
+		step = 2
+	} else if number, err := strconv.Atoi(word); err == nil {
+		self.pos++
+		step = number
+	}
+	unit, ok := scheduleUnitList[self.peek(0)]
+	if step != 1 {
+		unit, ok = scheduleUnitList[strings.TrimSuffix(self.peek(0), "s")]
+	}
+	if !ok {
+		return self.unexpected()
+	}
+	self.pos++
+	self.frequency = strings.Join(self.wordList[start:self.pos], " ")
+	if step != 1 && (step < 1 || step > scheduleStepList[unit]) {
+		return fmt.Errorf("%s cannot be written in cron", self.frequency)
+	}
+	self.unit, self.step = unit, step
+	return nil
+}
+
+func isWeekdayWord(word string) bool {
+	return word == "weekday" || word == "weekdays" || word == "weekend" || word == "weekends"
+}
+
+// parseWeekDays reads days of the week, i.e. "mondays", "mon, wed and fri", "monday to friday"
+func (self *scheduleCompiler) parseWeekDays() error {
+	if err := self.once("days of the week"); err != nil {
+		return err
+	}
+	itemList := make([]string, 0)
+	for {
+		word := self.next()
+		day, ok := scheduleDayList[strings.TrimSuffix(word, "s")]
+		switch {
+		case word == "weekday" || word == "weekdays":
+			itemList = append(itemList, "MON-FRI")
+		case word == "weekend" || word == "weekends":
+			itemList = append(itemList, "SAT,SUN")
+		case ok:
+			item := CronDays[day]
+			if scheduleRangeWordList[self.peek(0)] {
+				self.pos++
+				last, ok := scheduleDayList[strings.TrimSuffix(self.next(), "s")]
+				if !ok {
+					self.pos--
+					return self.unexpected()
+				}
+				item += "-" + CronDays[last]
+			}
+			itemList = append(itemList, item)
+		default:
+			self.pos--
+			return self.unexpected()
+		}
+
+		//a list goes on with a comma or "and" followed by a day
+		following := self.peek(1)
+		if _, ok := scheduleDayList[strings.TrimSuffix(following, "s")]; !(self.peek(0) == "," || self.peek(0) == "and") || !ok && !isWeekdayWord(following) {
+			break
+		}
+		self.pos++
+	}
+	self.dayOfWeek = strings.Join(itemList, ",")
+	return nil
+}
+
+// parseDays reads the days of the month or the nth day of the week of the month
+func (self *scheduleCompiler) parseDays() error {
+	self.accept("the")
+	word := self.peek(0)
+	nth, isNth := scheduleNthList[word]
+	if word == "last" || isNth {
+		self.pos++
+		following := self.next()
+		clause := "days of the month"
+		switch {
+		case following == "day" && word == "last":
+			self.dayOfMonth, self.withSpecialDays = "L", true
+		case following == "day":
+			self.dayOfMonth = strconv.Itoa(nth)
+		case following == "weekday" && word == "last":
+			self.dayOfMonth, self.withSpecialDays = "LW", true
+		case following == "weekday":
+			self.dayOfMonth, self.withSpecialDays = strconv.Itoa(nth)+"W", true
+		default:
+			day, ok := scheduleDayList[following]
+			if !ok {
+				self.pos--
+				return self.unexpected()
+			}
+			clause, self.withSpecialDays = "days of the week", true
+			if word == "last" {
+				self.dayOfWeek = CronDays[day] + "L"
+			} else {
+				self.dayOfWeek = CronDays[day] + "#" + strconv.Itoa(nth)
+			}
+		}
+		self.acceptOfMonth()
+		return self.once(clause)
+	}
+
+	if err := self.once("days of the month"); err != nil {
+		return err
+	}
+	self.accept("day", "days")
+	itemList := make([]string, 0)
+	for {
+		day, err := self.parseDayOfMonth()
+		if err != nil {
+			return err
+		}
+		item := strconv.Itoa(day)
+		if scheduleRangeWordList[self.peek(0)] {
+			self.pos++
+			last, err := self.parseDayOfMonth()
+			if err != nil {
+				return err
+			}
+			item += "-" + strconv.Itoa(last)
+		}
+		itemList = append(itemList, item)
+
+		if !(self.peek(0) == "," || self.peek(0) == "and") || !isDayOfMonthWord(self.peek(1)) {
+			break
+		}
+		self.pos++
+		self.accept("the")
+	}
+	self.accept("day")
+	self.acceptOfMonth()
+	self.dayOfMonth = strings.Join(itemList, ",")
+	return nil
+}
+
+func isDayOfMonthWord(word string) bool {
+	return scheduleDayRegexp.MatchString(word) || word == "the"
+}
+
+// parseDayOfMonth reads a day of the month, i.e. "15th" or "15" after "day"
+func (self *scheduleCompiler) parseDayOfMonth() (int, error) {
+	word := self.peek(0)
+	if match := scheduleDayRegexp.FindStringSubmatch(word); match != nil {
+		word = match[1]
+	}
+	day, err := strconv.Atoi(word)
+	if err != nil {
+		return 0, self.unexpected()
+	}
+	if day < 1 || day > 31 {
+		return 0, fmt.Errorf("there is no day %d in a month", day)
+	}
+	self.pos++
+	return day, nil
+}
+
+// parseTime reads a time of day, i.e. "9", "9:30am", "9 pm", "18:00", "noon"
+func (self *scheduleCompiler) parseTime() (scheduleTime, error) {
+	if self.accept("noon", "midday") {
+		return scheduleTime{hour: 12, withMeridiem: true}, nil
+	}
+	if self.accept("midnight") {
+		return scheduleTime{hour: 0, withMeridiem: true}, nil
+	}
+	match := scheduleTimeRegexp.FindStringSubmatch(self.peek(0))
+	if match == nil {
+		return scheduleTime{}, self.unexpected()
+	}
+	self.pos++
+	hour, _ := strconv.Atoi(match[1])
+	minute := 0
+	if match[2] != "" {
+		minute, _ = strconv.Atoi(match[2])
+	}
+	meridiem := match[3]
+	if meridiem == "" && (self.peek(0) == "am" || self.peek(0) == "pm") {
+		meridiem = self.next()
+	}
+	self.accept("o'clock")
+
+	if meridiem != "" {
+		if hour < 1 || hour > 12 {
+			return scheduleTime{}, fmt.Errorf("there is no %d %s", hour, meridiem)
+		}
+		hour %= 12
+		if meridiem == "pm" {
+			hour += 12
+		}
+	}
+	if hour > 23 || minute > 59 {
+		return scheduleTime{}, fmt.Errorf("there is no time %d:%02d", hour, minute)
+	}
+	return scheduleTime{hour: hour, minute: minute, withMeridiem: meridiem != ""}, nil
+}
+
+// parseTimes reads the times of day after "at", i.e. "8 and 20"
+func (self *scheduleCompiler) parseTimes() error {
+	if err := self.once("time of day"); err != nil {
+		return err
+	}
+	for {
+		time, err := self.parseTime()
+		if err != nil {
+			return err
+		}
+		self.timeList = append(self.timeList, time)
+		following := self.peek(1)
+		if !(self.peek(0) == "," || self.peek(0) == "and") ||
+			!scheduleTimeRegexp.MatchString(following) && following != "noon" && following != "midnight" {
+			return nil
+		}
+		self.pos++
+	}
+}
+
+// parsePastMinute reads "N minutes past the hour" after "at"
+func (self *scheduleCompiler) parsePastMinute() error {
+	if err := self.once("time of day"); err != nil {
+		return err
+	}
+	minute, _ := strconv.Atoi(self.next())
+	if minute > 59 {
+		return fmt.Errorf("there is no minute %d in an hour", minute)
+	}
+	self.pos++
+	for _, word := range []string{"past", "the", "hour"} {
+		if !self.accept(word) {
+			return self.unexpected()
+		}
+	}
+	self.pastMinute = strconv.Itoa(minute)
+	return nil
+}
+
+// parseBetween reads a time span, the end word is "and" after "between"
+func (self *scheduleCompiler) parseBetween(endWordList ...string) error {
+	if err := self.once("time span"); err != nil {
+		return err
+	}
+	first, err := self.parseTime()
+	if err != nil {
+		return err
+	}
+	if !self.accept(endWordList...) {
+		return self.unexpected()
+	}
+	last, err := self.parseTime()
+	if err != nil {
+		return err
+	}
+	//"between 9 and 5" ends in the afternoon
+	if !first.withMeridiem && !last.withMeridiem && last.hour <= first.hour && last.hour < 12 {
+		last.hour += 12
+	}
+	self.between = []scheduleTime{first, last}
+	return nil
+}
+
+// parseMonths reads months, i.e. "january", "jan and jul", "march to october"
+func (self *scheduleCompiler) parseMonths() error {
+	if err := self.once("months"); err != nil {
+		return err
+	}
+	itemList := make([]string, 0)
+	for {
+		month, ok := scheduleMonthList[self.peek(0)]
+		if !ok {
+			return self.unexpected()
+		}
+		self.pos++
+		item := strconv.Itoa(month)
+		if scheduleRangeWordList[self.peek(0)] {
+			self.pos++
+			last, ok := scheduleMonthList[self.peek(0)]
+			if !ok {
+				return self.unexpected()
+			}
+			self.pos++
+			item += "-" + strconv.Itoa(last)
+		}
+		itemList = append(itemList, item)
+
+		if _, ok := scheduleMonthList[self.peek(1)]; !(self.peek(0) == "," || self.peek(0) == "and") || !ok {
+			break
+		}
+		self.pos++
+	}
+	self.month = strings.Join(itemList, ",")
+	return nil
+}
+
+// parseYears reads a year or a range of years
+func (self *scheduleCompiler) parseYears() error {
+	if err := self.once("years"); err != nil {
+		return err
+	}
+	self.year = self.next()
+	if scheduleRangeWordList[self.peek(0)] && scheduleYearRegexp.MatchString(self.peek(1)) {
+		self.pos++
+		last := self.next()
+		if last < self.year {
+			return errors.New("a range of years cannot end before it starts")
+		}
+		self.year += "-" + last
+	}
+	return nil
+}
+
+// parseTimesPer reads "twice a day", "3 times an hour" and the like
+func (self *scheduleCompiler) parseTimesPer() error {
+	if err := self.once("times a day"); err != nil {
+		return err
+	}
+	count, ok := scheduleCountList[self.peek(0)]
+	if !ok {
+		number, err := strconv.Atoi(self.peek(0))
+		if err != nil || number < 1 {
+			return self.unexpected()
+		}
+		self.pos++
+		count = number
+	}
+	self.pos++
+	self.accept("times")
+	if !self.accept("a", "an", "per") {
+		return self.unexpected()
+	}
+	switch self.next() {
+	case "day":
+		self.timesPerDay = count
+	case "hour":
+		self.timesPerHour = count
+	default:
+		self.pos--
+		return self.unexpected()
+	}
+	return nil
+}
+
+// expression writes the fields read in the dialect of the compiler
+func (self *scheduleCompiler) expression() (string, error) {
+	seconds, minutes, hours := "0", "0", "0"
+	dayOfMonth, month, dayOfWeek := self.dayOfMonth, self.month, self.dayOfWeek
+	fnStep := func(from string) string {
+		if self.step == 1 {
+			return from
+		}
+		return from + "/" + strconv.Itoa(self.step)
+	}
+
+	withTime := len(self.timeList) > 0 || self.timesPerDay > 0
+	if self.unit != 0 && self.unit <= FieldHours && withTime {
+		return "", fmt.Errorf("%s cannot run at given times", self.frequency)
+	}
+	if self.between != nil && (self.unit == 0 || self.unit > FieldHours) && self.timesPerHour == 0 {
+		return "", errors.New("a time span needs every few seconds, minutes or hours")
+	}
+	if self.pastMinute != "" && self.unit != 0 && self.unit != FieldHours {
+		return "", errors.New("minutes past the hour need an hourly schedule")
+	}
+
+	switch self.unit {
+	case FieldSeconds:
+		seconds, minutes, hours = fnStep("*"), "*", "*"
+	case FieldMinutes:
+		minutes, hours = fnStep("*"), "*"
+	case FieldHours:
+		hours = fnStep("*")
+	case FieldDayOfMonth:
+		if self.step > 1 {
+			if dayOfMonth != "" {
+				return "", errors.New("every few days cannot have days of the month")
+			}
+			dayOfMonth = fnStep("*")
+		}
+	case FieldDayOfWeek:
+		if dayOfMonth == "" && dayOfWeek == "" {
+			dayOfWeek = CronDays[0]
+		}
+	case FieldMonth:
+		if self.step > 1 {
+			if month != "" {
+				return "", errors.New("every few months cannot have months")
+			}
+			month = fnStep("*")
+		}
+		if dayOfMonth == "" && dayOfWeek == "" {
+			dayOfMonth = "1"
+		}
+	case FieldYear:
+		if dayOfMonth == "" && dayOfWeek == "" {
+			dayOfMonth = "1"
+		}
+		if month == "" {
+			month = "1"
+		}
+	}
+
+	if self.pastMinute != "" {
+		minutes = self.pastMinute
+		if self.unit == 0 {
+			hours = "*"
+		}
+	}
+	if self.timesPerHour > 0 {
+		if self.unit != 0 || self.pastMinute != "" || 60%self.timesPerHour != 0 {
+			return "", fmt.Errorf("%d times an hour cannot be written in cron", self.timesPerHour)
+		}
+		minutes, hours = spreadTimes(self.timesPerHour, 60), "*"
+	}
+	if self.between != nil {
+		first, last := self.between[0], self.between[1]
+		if first.minute != 0 || last.minute != 0 {
+			return "", errors.New("a time span is given in whole hours")
+		}
+		//the end hour is left out unless the schedule runs on the hour
+		if self.unit != FieldHours {
+			last.hour--
+		}
+		if last.hour < first.hour {
+			return "", errors.New("a time span cannot end before it starts")
+		}
+		hours = strconv.Itoa(first.hour) + "-" + strconv.Itoa(last.hour)
+		if self.unit == FieldHours {
+			hours = fnStep(hours)
+		}
+	}
+
+	if len(self.timeList) > 0 {
+		var err error
+		if minutes, hours, err = joinScheduleTimes(self.timeList); err != nil {
+			return "", err
+		}
+		if self.timesPerDay > 0 && len(self.timeList) != self.timesPerDay {
+			return "", fmt.Errorf("%d times a day given %d times", self.timesPerDay, len(self.timeList))
+		}
+	} else if self.timesPerDay > 0 {
+		if 24%self.timesPerDay != 0 {
+			return "", fmt.Errorf("%d times a day cannot be written in cron", self.timesPerDay)
+		}
+		hours = spreadTimes(self.timesPerDay, 24)
+	}
+
+	if self.unit == 0 && !withTime && self.pastMinute == "" && self.timesPerHour == 0 &&
+		dayOfMonth == "" && dayOfWeek == "" && month == "" && self.year == "" {
+		return "", errors.New("schedule says nothing of when to run")
+	}
+	return self.writeExpression(seconds, minutes, hours, dayOfMonth, month, dayOfWeek)
+}
+
+// spreadTimes lists count values evenly spread over size, i.e. 0,12 for twice a day
+func spreadTimes(count int, size int) string {
+	itemList := make([]string, 0)
+	for value := 0; value < size; value += size / count {
+		itemList = append(itemList, strconv.Itoa(value))
+	}
+	return strings.Join(itemList, ",")
+}
+
+// joinScheduleTimes writes times of day as the minutes and the hours of an expression, which
+// only holds when they share their minutes or their hour
+func joinScheduleTimes(timeList []scheduleTime) (string, string, error) {
+	minuteList, hourList := make([]string, 0), make([]string, 0)
+	sameMinute, sameHour := true, true
+	for _, time := range timeList {
+		sameMinute = sameMinute && time.minute == timeList[0].minute
+		sameHour = sameHour && time.hour == timeList[0].hour
+		minuteList = append(minuteList, strconv.Itoa(time.minute))
+		hourList = append(hourList, strconv.Itoa(time.hour))
+	}
+	switch {
+	case sameMinute:
+		return minuteList[0], strings.Join(hourList, ","), nil
+	case sameHour:
+		return strings.Join(minuteList, ","), hourList[0], nil
+	default:
+		return "", "", errors.New("the times given do not fit in a single expression")
+	}
+}
+
+// writeExpression joins the fields in the dialect of the compiler, the day fields left
+// empty run every day
+func (self *scheduleCompiler) writeExpression(seconds, minutes, hours, dayOfMonth, month, dayOfWeek string) (string, error) {
+	if dayOfMonth != "" && dayOfWeek != "" && self.dialect != DialectAnd {
+		return "", errors.New("both a day of the month and a day of the week cannot be given in this dialect")
+	}
+	if month == "" {
+		month = "*"
+	}
+
+	partList := make([]string, 0)
+	switch self.dialect {
+	case DialectQuartz:
+		if dayOfMonth == "" {
+			dayOfMonth = "?"
+		}
+		if dayOfWeek == "" {
+			dayOfWeek = "?"
+			if dayOfMonth == "?" {
+				dayOfMonth = "*"
+			}
+		}
+		partList = append(partList, seconds, minutes, hours, dayOfMonth, month, dayOfWeek)
+	case DialectVixie:
+		if seconds != "0" || self.year != "" || self.withSpecialDays {
+			return "", errors.New("unix cron has no seconds, years, L, W or #")
+		}
+		fallthrough
+	default:
+		if dayOfMonth == "" {
+			dayOfMonth = "*"
+		}
+		if dayOfWeek == "" {
+			dayOfWeek = "*"
+		}
+		if seconds != "0" {
+			partList = append(partList, seconds)
+		}
+		partList = append(partList, minutes, hours, dayOfMonth, month, dayOfWeek)
+	}
+	if self.year != "" {
+		partList = append(partList, self.year)
+	}
+	return strings.Join(partList, " "), nil
+}
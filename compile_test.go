@@ -0,0 +1,99 @@
+package main
+
+import "testing"
+
+func TestCompileSchedule(t *testing.T) {
+	testList := []struct {
+		dialect     int
+		schedule    string
+		expected    string
+		description string
+	}{
+		{DialectAnd, "every weekday at 9:30am", "30 9 * * MON-FRI", "At 09:30 AM on weekdays"},
+		{DialectAnd, "every 15 minutes between 9 and 5", "*/15 9-16 * * *", "Every 15 minutes, between 09:00 AM and 04:45 PM"},
+		{DialectAnd, "last Friday of the month at 18:00", "0 18 * * FRIL", "At 06:00 PM, on the last Friday of the month"},
+		{DialectAnd, "twice a day at 8 and 20", "0 8,20 * * *", "At 08:00 AM and 08:00 PM"},
+		{DialectAnd, "every 2 hours between 8 and 20", "0 8-20/2 * * *", "Every 2 hours, between 08:00 AM and 08:00 PM"},
+		{DialectAnd, "on the 1st and 15th at noon", "0 12 1,15 * *", "At noon, on the 1st and 15th of the month"},
+		{DialectAnd, "on mondays and fridays at 7 pm", "0 19 * * MON,FRI", "At 07:00 PM, only on Monday and Friday"},
+		{DialectAnd, "in January and July on the 1st", "0 0 1 1,7 *", "At midnight, on the 1st of the month, only in January and July"},
+		{DialectAnd, "on the last weekday of the month at 17:00", "0 17 LW * *", "At 05:00 PM, on the last weekday of the month"},
+		{DialectAnd, "the first Monday of the month at 9", "0 9 * * MON#1", "At 09:00 AM, on the 1st Monday of the month"},
+		{DialectAnd, "from March to October every day at 6", "0 6 * 3-10 *", "At 06:00 AM, March through October"},
+		{DialectAnd, "at 15 minutes past the hour", "15 * * * *", "At 15 minutes past the hour"},
+		{DialectAnd, "every 10 seconds", "*/10 * * * * *", "Every 10 seconds"},
+		{DialectAnd, "every day at 7 in 2027", "0 7 * * * 2027", "At 07:00 AM, only in 2027"},
+		{DialectAnd, "at noon on the 13th on Fridays", "0 12 13 * FRI", "At noon, on the 13th of the month, only on Friday"},
+		{DialectAnd, "weekly", "0 0 * * SUN", "At midnight, only on Sunday"},
+		{DialectAnd, "every 3 months", "0 0 1 */3 *", "Quarterly"},
+		{DialectVixie, "every weekend at midnight", "0 0 * * SAT,SUN", "At midnight on weekends"},
+		{DialectQuartz, "every weekday at 9:30am", "0 30 9 ? * MON-FRI", "At 09:30 AM on weekdays"},
+		{DialectQuartz, "every 15 minutes", "0 */15 * * * ?", "Every quarter hour"},
+		{DialectQuartz, "last Friday of the month at 18:00", "0 0 18 ? * FRIL", "At 06:00 PM, on the last Friday of the month"},
+		{DialectQuartz, "monthly", "0 0 0 1 * ?", "Monthly"},
+	}
+
+	for _, val := range testList {
+		expression, err := CompileSchedule(val.schedule, val.dialect)
+		if err != nil {
+			t.Errorf("%s: %v", val.schedule, err)
+			continue
+		}
+		if expression != val.expected {
+			t.Errorf("%s: expected %q, got %q", val.schedule, val.expected, expression)
+		}
+		//describing the expression gives the schedule back
+		opts := NewDefaultOptions()
+		opts.Dialect = val.dialect
+		if description := NewDescriptor(expression, opts).GetDescription(); description != val.description {
+			t.Errorf("%s: expected %q, got %q", expression, val.description, description)
+		}
+	}
+}
+
+func TestCompileSchedule_error(t *testing.T) {
+	testList := []struct {
+		dialect  int
+		schedule string
+	}{
+		{DialectAnd, ""},
+		{DialectAnd, "whenever"},
+		{DialectAnd, "every 90 minutes"},
+		{DialectAnd, "every day at 9 and 17:30"},
+		{DialectAnd, "every 15 minutes at 9"},
+		{DialectAnd, "between 9 and 5"},
+		{DialectAnd, "at 25:00"},
+		{DialectAnd, "on the 32nd"},
+		{DialectAnd, "every monday every friday"},
+		{DialectAnd, "in 2027 to 2025"},
+		{DialectAnd, "twice a day at 8"},
+		{DialectVixie, "every 10 seconds"},
+		{DialectVixie, "on the last day of the month"},
+		{DialectQuartz, "at noon on the 13th on Fridays"},
+	}
+
+	for _, val := range testList {
+		if expression, err := CompileSchedule(val.schedule, val.dialect); err == nil {
+			t.Errorf("%q: expected an error, got %q", val.schedule, expression)
+		}
+	}
+}
+
+func TestCompileSchedule_errorMessage(t *testing.T) {
+	testList := []struct {
+		schedule string
+		expected string
+	}{
+		{"every 90 minutes", "every 90 minutes cannot be written in cron"},
+		{"each 2 weeks", "each 2 weeks cannot be written in cron"},
+		{"every hour at 9", "every hour cannot run at given times"},
+		{"hourly at 9", "hourly cannot run at given times"},
+		{"in 2027 to 2025", "a range of years cannot end before it starts"},
+	}
+
+	for _, val := range testList {
+		if _, err := CompileSchedule(val.schedule, DialectAnd); err == nil || err.Error() != val.expected {
+			t.Errorf("%q: expected %q, got %v", val.schedule, val.expected, err)
+		}
+	}
+}
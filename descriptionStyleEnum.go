@@ -0,0 +1,9 @@
+package main
+
+const (
+	//DescriptionStyleEnum
+	unuseStyle = iota
+	StyleLong
+	StyleShort
+	StyleCompact
+)
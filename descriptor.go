@@ -4,10 +4,14 @@ import (
 	"cron-descriptor/locale"
 	"errors"
 	"fmt"
+	"golang.org/x/text/cases"
 	"golang.org/x/text/message"
+	"golang.org/x/text/number"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 var (
@@ -29,8 +33,18 @@ var (
 
 	specialCharactersList = []string{"/", "-", ",", "*"}
 	specialCharacters     = strings.Join(specialCharactersList, "")
+
+	//a word is a run of letters and digits, apostrophes inside a word do not split it
+	wordRegexp = regexp.MustCompile(`[\p{L}\p{M}\p{N}]+(?:['’][\p{L}\p{M}]+)*`)
+
+	//numbers and times embedded in the description, i.e. 2005, 10:15, 02:00 PM
+	numberRegexp = regexp.MustCompile(`\d+(?::\d+)*(?: [AP]M)?`)
+	digitsRegexp = regexp.MustCompile(`\d+`)
 )
 
+//at most this many times of day are listed one by one, more are summarized
+const maxTimeListLength = 6
+
 type descriptor struct {
 	Expression string
 	Printer    *message.Printer
@@ -56,154 +70,286 @@ func NewDescriptor(expression string, opts *options) *descriptor {
 }
 
 func (self *descriptor) GetDescription() string {
+	segments, err := self.getSegments()
+	if err != nil {
+		return self.renderError(err)
+	}
+	return self.render(segments)
+}
+
+// GetSegments describes the expression as an ordered list of segments,
+// joined together they are the plain text GetDescription returns
+func (self *descriptor) GetSegments() ([]Segment, error) {
+	segments, err := self.getSegments()
+	if err != nil {
+		return nil, err
+	}
+	//the marks of SSML output are only rendered by GetDescription
+	return transformSegments(segments, stripSSMLMarks), nil
+}
+
+func (self *descriptor) getSegments() ([]Segment, error) {
 	entity, err := parse(self)
 	if err != nil {
-		return err.Error()
+		return nil, err
 	}
-	description := ""
+	return self.describe(entity)
+}
+
+// describe describes the parsed expression in the description type of the options
+func (self *descriptor) describe(entity *cronEntity) ([]Segment, error) {
+	var err error
+	segments := make([]Segment, 0)
 
 	switch self.Options.DescriptionType {
 	case DescFull:
-		description, err = self.getFullDescription(entity)
-		if err != nil {
-			description = err.Error()
+		if self.isShortStyle() {
+			segments, err = self.getShortSegments(entity)
+		} else {
+			segments, err = self.getFullSegments(entity)
 		}
 
 	case DescTimeOfDay:
-		description, err = self.getTimeOfDayDescription(entity)
-		if err != nil {
-			description = err.Error()
-		}
+		segments, err = self.getTimeOfDaySegments(entity)
 
 	case DescHours:
-		description = self.getHoursDescription(entity)
+		segments = append(segments, self.fieldSegment(entity, FieldHours, self.getHoursDescription))
 
 	case DescMinutes:
-		description = self.getMinutesDescription(entity)
+		segments = append(segments, self.fieldSegment(entity, FieldMinutes, self.getMinutesDescription))
 
 	case DescSeconds:
-		description = self.getSecondsDescription(entity)
+		segments = append(segments, self.fieldSegment(entity, FieldSeconds, self.getSecondsDescription))
 
 	case DescDayOfMonth:
-		description = self.getDayOfMonthDescription(entity)
+		segments = append(segments, self.fieldSegment(entity, FieldDayOfMonth, self.getDayOfMonthDescription))
 
 	case DescMonth:
-		description = self.getMonthDescription(entity)
+		segments = append(segments, self.fieldSegment(entity, FieldMonth, self.getMonthDescription))
 
 	case DescDayOfWeek:
-		description = self.getDayOfWeekDescription(entity)
+		segments = append(segments, self.fieldSegment(entity, FieldDayOfWeek, self.getDayOfWeekDescription))
 
 	case DescYear:
-		description = self.getYearDescription(entity)
+		segments = append(segments, self.fieldSegment(entity, FieldYear, self.getYearDescription))
 
 	default:
-		description = "error type"
+		err = errors.New("error type")
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return description
+	if self.Options.DescriptionType == DescFull {
+		if segment, ok := self.getExampleSegment(entity); ok {
+			segments = append(segments, segment)
+		}
+		if segment, ok := self.getFiringSegment(entity); ok {
+			segments = append(segments, segment)
+		}
+	}
+
+	return transformSegments(segments, func(description string) string {
+		description = locale.Euphony(self.Options.Language, self.resolveNames(description, locale.Nominative))
+		description = self.transformDirection(description)
+		return self.transformDigits(description)
+	}), nil
 }
 
-func (self *descriptor) getFullDescription(entity *cronEntity) (string, error) {
-	timeSegment, err := self.getTimeOfDayDescription(entity)
+func (self *descriptor) getFullSegments(entity *cronEntity) ([]Segment, error) {
+	segments, err := self.getTimeOfDaySegments(entity)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	dayOfMonthSegment := self.fieldSegment(entity, FieldDayOfMonth, self.getDayOfMonthDescription)
+	dayOfWeekSegment := self.fieldSegment(entity, FieldDayOfWeek, self.getDayOfWeekDescription)
+	monthSegment := self.fieldSegment(entity, FieldMonth, self.getMonthDescription)
+	yearSegment := self.fieldSegment(entity, FieldYear, self.getYearDescription)
+
+	//the exhaustive description spells out every field, the summaries would hide some
+	if self.Options.Summarize && self.Options.Verbosity < VerbosityExhaustive {
+		if segment, ok := self.summarizeTimeOfDay(entity); ok {
+			segments = []Segment{segment}
+		}
+		if segment, ok := self.summarizeDaysOfWeek(entity); ok {
+			dayOfWeekSegment = segment
+		}
+		if segment, withTime, ok := self.summarizeDates(entity); ok {
+			if withTime {
+				segments = make([]Segment, 0)
+			}
+			dayOfMonthSegment, monthSegment = segment, Segment{}
+		}
 	}
 
-	dayOfMonthDesc := self.getDayOfMonthDescription(entity)
-	monthDesc := self.getMonthDescription(entity)
-	dayOfWeekDesc := self.getDayOfWeekDescription(entity)
-	yearDesc := self.getYearDescription(entity)
+	if daysEitherMatch(entity, self.Options) {
+		dayOfMonthSegment, dayOfWeekSegment = self.joinEitherDays(entity, dayOfMonthSegment, dayOfWeekSegment), Segment{}
+	}
 
-	description := fmt.Sprintf("%s%s%s%s%s", timeSegment, dayOfMonthDesc, dayOfWeekDesc, monthDesc, yearDesc)
-	description = self.transformVerbosity(description)
-	description = self.transformCase(description)
+	segments = append(segments, dayOfMonthSegment, dayOfWeekSegment, monthSegment, yearSegment)
 
-	return description, nil
+	return transformSegments(segments, func(description string) string {
+		description = self.resolveNames(description, locale.Nominative)
+		return self.transformCase(description)
+	}), nil
 }
 
-func (self *descriptor) getTimeOfDayDescription(entity *cronEntity) (string, error) {
+func (self *descriptor) getTimeOfDaySegments(entity *cronEntity) ([]Segment, error) {
 	secondsExp := entity.Seconds
 	minutesExp := entity.Minutes
 	hoursExp := entity.Hours
 
-	description := make([]string, 0)
-
-	//handle special cases first
-	if !strings.ContainsAny(secondsExp, specialCharacters) &&
-		!strings.ContainsAny(minutesExp, specialCharacters) &&
-		!strings.ContainsAny(hoursExp, specialCharacters) {
-
-		//specific time of day (i.e. 10 14)
-		formatTimeStr, err := self.formatTime(hoursExp, minutesExp, secondsExp)
-		if err != nil {
-			return "", err
+	secondList, secondsOk := expandField(secondsExp, 0, 59)
+	minuteList, minutesOk := expandField(minutesExp, 0, 59)
+	hourList, hoursOk := expandField(hoursExp, 0, 23)
+	//a field that does not parse is described on its own
+	isValid := !entity.isInvalid(FieldSeconds) && !entity.isInvalid(FieldMinutes) && !entity.isInvalid(FieldHours)
+	isExpanded := isValid && secondsOk && minutesOk && hoursOk
+	isOncePerHour := isExpanded && len(secondList) == 1 && len(minuteList) == 1
+
+	formatTimeOfDay := func(hour, minute, second int) (string, error) {
+		secondExp := ""
+		if secondsExp != "" {
+			secondExp = strconv.Itoa(second)
 		}
-		description = append(description, self.Printer.Sprintf("At "), formatTimeStr)
+		return self.formatTime(strconv.Itoa(hour), strconv.Itoa(minute), secondExp)
+	}
 
-	} else if strings.Contains(minutesExp, "-") &&
-		!strings.Contains(minutesExp, ",") &&
+	//handle special cases first
+	if isValid && secondsExp == "" &&
+		strings.Contains(minutesExp, "-") &&
+		!strings.ContainsAny(minutesExp, ",/") &&
 		!strings.ContainsAny(hoursExp, specialCharacters) {
 
 		//minute range in single hour (i.e. 0-10 11)
 		minuteParts := strings.Split(minutesExp, "-")
 		minuteBtw0, err := self.formatTime(hoursExp, minuteParts[0], "")
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		minuteBtw1, err := self.formatTime(hoursExp, minuteParts[1], "")
 		if err != nil {
-			return "", err
+			return nil, err
 		}
-		description = append(description, self.Printer.Sprintf("Every minute between %s and %s", minuteBtw0, minuteBtw1))
-
-	} else if strings.Contains(hoursExp, ",") &&
-		!strings.Contains(hoursExp, "-") &&
-		!strings.ContainsAny(minutesExp, specialCharacters) {
-
-		//hours list with single minute (o.e. 30 6,14,16)
-		hourParts := strings.Split(hoursExp, ",")
-		hourPartsLength := len(hourParts)
-		description = append(description, self.Printer.Sprintf("At"))
-		for i, hourPart := range hourParts {
-			hourFormat, err := self.formatTime(hourPart, minutesExp, "")
-			if err != nil {
-				return "", err
-			}
-			description = append(description, " ", hourFormat)
-			if i < (hourPartsLength - 2) {
-				description = append(description, ",")
+		description := self.sprintf("Every minute between %s and %s", minuteBtw0, minuteBtw1)
+		return []Segment{newSegment(entity, SegmentRange, description, FieldMinutes, FieldHours)}, nil
+
+	} else if isExpanded &&
+		(len(secondList)*len(minuteList)*len(hourList) <= maxTimeListLength ||
+			isOncePerHour && !strings.ContainsAny(hoursExp, "*-/")) {
+
+		//few times of day or an hour list with a single minute, all of them are listed
+		//(i.e. 15,45 10,14)
+		timeList := make([]string, 0)
+		for _, hour := range hourList {
+			for _, minute := range minuteList {
+				for _, second := range secondList {
+					timeStr, err := formatTimeOfDay(hour, minute, second)
+					if err != nil {
+						return nil, err
+					}
+					timeList = append(timeList, timeStr)
+				}
 			}
-			if i == (hourPartsLength - 2) {
-				description = append(description, self.Printer.Sprintf(" and"))
+		}
+		kind := SegmentList
+		if len(timeList) == 1 {
+			kind = SegmentSingle
+		}
+		description := self.Printer.Sprintf("At %s", self.joinList(timeList, self.Printer.Sprintf(" and ")))
+		return []Segment{newSegment(entity, kind, description, FieldSeconds, FieldMinutes, FieldHours)}, nil
+	}
+
+	//summarize the fields one by one, the clause separator goes with the segment it introduces
+	clauseSeparator := locale.ClauseSeparator(self.Options.Language)
+	secondsSegment := self.fieldSegment(entity, FieldSeconds, self.getSecondsDescription)
+	minutesSegment := self.fieldSegment(entity, FieldMinutes, self.getMinutesDescription)
+	hoursSegment := self.fieldSegment(entity, FieldHours, self.getHoursDescription)
+
+	if isExpanded {
+		//the hours are bounded by the first and the last time they actually run at
+		//(i.e. 0/30 9-17 runs between 09:00 and 17:30)
+		windowDescription, err := self.getHourWindowDescription(hoursExp, hourList, func(hour int, isLast bool) (string, error) {
+			if isLast {
+				return formatTimeOfDay(hour, minuteList[len(minuteList)-1], secondList[len(secondList)-1])
 			}
+			return formatTimeOfDay(hour, minuteList[0], secondList[0])
+		})
+		if err != nil {
+			return nil, err
 		}
 
-	} else {
-		//default time description
-		secondsDescription := self.getSecondsDescription(entity)
-		minutesDescription := self.getMinutesDescription(entity)
-		hoursDescription := self.getHoursDescription(entity)
+		if windowDescription != "" {
+			every := self.Printer.Sprintf("every hour")
+			if strings.Contains(hoursExp, "/") {
+				every = self.countf("every %s hours", strings.SplitN(hoursExp, "/", 2)[1])
+			}
 
-		if secondsDescription != "" {
-			description = append(description, secondsDescription)
+			if isOncePerHour {
+				//the bounds already carry the minute and the second
+				description := every + clauseSeparator + windowDescription
+				hoursSegment = newSegment(entity, segmentKind(hoursExp), description, FieldSeconds, FieldMinutes, FieldHours)
+				secondsSegment, minutesSegment = Segment{}, Segment{}
+			} else if strings.Contains(hoursExp, "/") {
+				hoursSegment.Text = every + clauseSeparator + windowDescription
+			} else {
+				hoursSegment.Text = windowDescription
+			}
 		}
+	}
 
-		if len(description) > 0 {
-			description = append(description, ", ")
+	segments := make([]Segment, 0)
+	for _, segment := range []Segment{secondsSegment, minutesSegment, hoursSegment} {
+		if segment.Text == "" {
+			continue
 		}
-		if minutesDescription != "" {
-			description = append(description, minutesDescription)
+		if len(segments) > 0 {
+			//every minute or hour goes without saying once a smaller unit is described
+			if segment.Kind == SegmentAll && self.Options.Verbosity < VerbosityVerbose {
+				continue
+			}
+			segment.Text = clauseSeparator + segment.Text
 		}
+		segments = append(segments, segment)
+	}
+
+	return segments, nil
+}
+
+// getHourWindowDescription describes the runs of consecutive hours as windows between their
+// first and last time, or a stepped range as one window. It is empty for every hour and
+// for steps over the whole day, which read better as they are.
+func (self *descriptor) getHourWindowDescription(
+	hoursExp string,
+	hourList []int,
+	fnFormatBound func(hour int, isLast bool) (string, error),
+) (string, error) {
+	if hoursExp == "*" ||
+		strings.Contains(hoursExp, "/") && (strings.Contains(hoursExp, ",") || !strings.Contains(hoursExp, "-")) {
+		return "", nil
+	}
 
-		if len(description) > 0 {
-			description = append(description, ", ")
+	runList := make([][]int, 0)
+	if strings.Contains(hoursExp, "/") {
+		runList = append(runList, []int{hourList[0], hourList[len(hourList)-1]})
+	} else {
+		runList = valueRuns(hourList)
+	}
+
+	windowList := make([]string, 0)
+	for _, run := range runList {
+		first, err := fnFormatBound(run[0], false)
+		if err != nil {
+			return "", err
 		}
-		if hoursDescription != "" {
-			description = append(description, hoursDescription)
+		last, err := fnFormatBound(run[1], true)
+		if err != nil {
+			return "", err
 		}
+		windowList = append(windowList, self.sprintf("between %s and %s", first, last))
 	}
-
-	return strings.Join(description, ""), nil
+	return self.joinList(windowList, self.Printer.Sprintf(" and ")), nil
 }
 
 func (self *descriptor) getSecondsDescription(entity *cronEntity) string {
@@ -212,10 +358,10 @@ func (self *descriptor) getSecondsDescription(entity *cronEntity) string {
 		return printer.Sprintf("every second")
 	}
 	fnGetSingleItemDescription := func(_ *message.Printer, s string) string {
-		return s
+		return self.speakNumber(s)
 	}
-	fnGetIntervalDescriptionFormat := func(printer *message.Printer, format, _ string) string {
-		return printer.Sprintf("every %s seconds", format)
+	fnGetIntervalDescriptionFormat := func(_ *message.Printer, format, _ string) string {
+		return self.countf("every %s seconds", format)
 	}
 	fnGetBetweenDescriptionFormat := func(printer *message.Printer, _ string, s ...string) string {
 		maxParams := 2
@@ -247,10 +393,10 @@ func (self *descriptor) getMinutesDescription(entity *cronEntity) string {
 		return printer.Sprintf("every minute")
 	}
 	fnGetSingleItemDescription := func(printer *message.Printer, s string) string {
-		return s
+		return self.speakNumber(s)
 	}
-	fnGetIntervalDescriptionFormat := func(printer *message.Printer, format, _ string) string {
-		return printer.Sprintf("every %s minutes", format)
+	fnGetIntervalDescriptionFormat := func(_ *message.Printer, format, _ string) string {
+		return self.countf("every %s minutes", format)
 	}
 	fnGetBetweenDescriptionFormat := func(printer *message.Printer, _ string, s ...string) string {
 		maxParams := 2
@@ -263,8 +409,8 @@ func (self *descriptor) getMinutesDescription(entity *cronEntity) string {
 		}
 		return printer.Sprintf("minutes %s through %s past the hour", objectList...)
 	}
-	fnGetDescriptionFormat := func(printer *message.Printer, _, s string) string {
-		if s == "0" {
+	fnGetDescriptionFormat := func(printer *message.Printer, exp, s string) string {
+		if exp == "0" {
 			return ""
 		} else {
 			return printer.Sprintf("at %s minutes past the hour", s)
@@ -293,8 +439,8 @@ func (self *descriptor) getHoursDescription(entity *cronEntity) string {
 		}
 		return hourStr
 	}
-	fnGetIntervalDescriptionFormat := func(printer *message.Printer, format, _ string) string {
-		return printer.Sprintf("every %s hours", format)
+	fnGetIntervalDescriptionFormat := func(_ *message.Printer, format, _ string) string {
+		return self.countf("every %s hours", format)
 	}
 	fnGetBetweenDescriptionFormat := func(printer *message.Printer, _ string, s ...string) string {
 		maxParams := 2
@@ -305,7 +451,7 @@ func (self *descriptor) getHoursDescription(entity *cronEntity) string {
 				break
 			}
 		}
-		return printer.Sprintf("between %s and %s", objectList...)
+		return self.sprintf("between %s and %s", objectList...)
 	}
 	fnGetDescriptionFormat := func(printer *message.Printer, _, s string) string {
 		return printer.Sprintf("at %s", s)
@@ -321,7 +467,11 @@ func (self *descriptor) getHoursDescription(entity *cronEntity) string {
 }
 
 func (self *descriptor) getDayOfWeekDescription(entity *cronEntity) string {
-	if entity.DayOfWeek == "*" && entity.DayOfMonth != "*" {
+	if entity.DayOfWeek == "*" {
+		//the day of the month describes every day
+		if entity.DayOfMonth != "*" && self.Options.Verbosity >= VerbosityExhaustive {
+			return self.Printer.Sprintf(", on any day of the week")
+		}
 		return ""
 	}
 
@@ -340,10 +490,10 @@ func (self *descriptor) getDayOfWeekDescription(entity *cronEntity) string {
 		if err != nil {
 			return ""
 		}
-		return self.numberToDay(expNum)
+		return self.dayReference(expNum)
 	}
-	fnGetIntervalDescriptionFormat := func(printer *message.Printer, format, _ string) string {
-		return printer.Sprintf(", every %s days of the week", format)
+	fnGetIntervalDescriptionFormat := func(_ *message.Printer, format, _ string) string {
+		return self.countf(", every %s days of the week", format)
 	}
 	fnGetBetweenDescriptionFormat := func(printer *message.Printer, _ string, s ...string) string {
 		maxParams := 2
@@ -354,10 +504,13 @@ func (self *descriptor) getDayOfWeekDescription(entity *cronEntity) string {
 				break
 			}
 		}
-		return printer.Sprintf(", %s through %s", objectList...)
+		return self.sprintf(", %s through %s", objectList...)
 	}
 	fnGetDescriptionFormat := func(printer *message.Printer, format, s string) string {
-		if strings.Contains(format, "#") {
+		if strings.Contains(format, ",") && strings.Contains(format, "#") {
+			return self.getNthDaysOfWeekDescription(format)
+
+		} else if strings.Contains(format, "#") {
 			dayOfWeekOfMonthList := strings.SplitN(format, "#", 2)
 			dayOfWeekOfMonth := ""
 			dayOfWeekOfMonthDescription := ""
@@ -365,24 +518,26 @@ func (self *descriptor) getDayOfWeekDescription(entity *cronEntity) string {
 				dayOfWeekOfMonth = dayOfWeekOfMonthList[1]
 			}
 
-			if num, err := strconv.Atoi(dayOfWeekOfMonth); err == nil && num >= 1 && num <= 5 {
-				choices := map[int]string{
-					1: "first",
-					2: "second",
-					3: "third",
-					4: "forth",
-					5: "fifth",
-				}
-				dayOfWeekOfMonthDescription = choices[num]
+			if num, err := strconv.Atoi(dayOfWeekOfMonth); err == nil && num >= 1 {
+				//the ordinal agrees with the day name, i.e. "3-ю субботу"
+				dayNumber, _ := strconv.Atoi(dayOfWeekOfMonthList[0])
+				ordinal := self.ordinal(num, locale.DayGender(self.Options.Language, dayNumber))
+				dayOfWeekOfMonthDescription = self.sprintf(", on the %s %s of the month", ordinal, s)
 			}
 
-			return printer.Sprintf(", on the ") + printer.Sprintf(dayOfWeekOfMonthDescription) + printer.Sprintf(" %s of the month", s)
+			return dayOfWeekOfMonthDescription
 
 		} else if strings.Contains(format, "L") {
-			return printer.Sprintf(", on the last %s of the month", s)
+			return self.sprintf(", on the last %s of the month", s)
+
+		} else if daysEitherMatch(entity, self.Options) {
+			return self.sprintf(", on any %s", s)
+
+		} else if self.Options.Verbosity <= VerbosityTerse {
+			return self.sprintf(", on %s", s)
 
 		} else {
-			return printer.Sprintf(", only on %s", s)
+			return self.sprintf(", only on %s", s)
 		}
 	}
 
@@ -395,8 +550,73 @@ func (self *descriptor) getDayOfWeekDescription(entity *cronEntity) string {
 		fnGetDescriptionFormat)
 }
 
+// getNthDaysOfWeekDescription describes a list of nth days of the week (i.e. "1#1,1#3,3#1,3#3")
+// grouping the days which fall on the same ordinals, "on the first and third Monday and
+// Wednesday of the month". The ordinals of a group agree with the gender of its days.
+func (self *descriptor) getNthDaysOfWeekDescription(expression string) string {
+	type nthDayGroup struct {
+		ordinalList []int
+		dayList     []int
+	}
+	ordinalListByDay := make(map[int][]int)
+	dayList := make([]int, 0)
+	for _, item := range strings.Split(expression, ",") {
+		itemList := strings.SplitN(item, "#", 2)
+		if len(itemList) != 2 {
+			return ""
+		}
+		day, err := strconv.Atoi(itemList[0])
+		if err != nil {
+			return ""
+		}
+		ordinal, err := strconv.Atoi(itemList[1])
+		if err != nil || ordinal < 1 {
+			return ""
+		}
+		if _, ok := ordinalListByDay[day]; !ok {
+			dayList = append(dayList, day)
+		}
+		ordinalListByDay[day] = append(ordinalListByDay[day], ordinal)
+	}
+
+	groupList := make([]*nthDayGroup, 0)
+	groupByKey := make(map[string]*nthDayGroup)
+	for _, day := range dayList {
+		ordinalList := ordinalListByDay[day]
+		sort.Ints(ordinalList)
+		key := fmt.Sprint(ordinalList, locale.DayGender(self.Options.Language, day))
+		group, ok := groupByKey[key]
+		if !ok {
+			group = &nthDayGroup{ordinalList: ordinalList}
+			groupByKey[key] = group
+			groupList = append(groupList, group)
+		}
+		group.dayList = append(group.dayList, day)
+	}
+
+	description := ""
+	for _, group := range groupList {
+		gender := locale.DayGender(self.Options.Language, group.dayList[0])
+		ordinalList := make([]string, 0)
+		for _, ordinal := range group.ordinalList {
+			ordinalList = append(ordinalList, self.ordinal(ordinal, gender))
+		}
+		dayReferenceList := make([]string, 0)
+		for _, day := range group.dayList {
+			dayReferenceList = append(dayReferenceList, self.dayReference(day))
+		}
+		description += self.sprintf(", on the %s %s of the month",
+			self.joinList(ordinalList, self.Printer.Sprintf(", and ")),
+			self.joinList(dayReferenceList, self.Printer.Sprintf(", and ")))
+	}
+	return description
+}
+
 func (self *descriptor) getMonthDescription(entity *cronEntity) string {
-	fnAllDescription := func(_ *message.Printer) string {
+	fnAllDescription := func(printer *message.Printer) string {
+		if self.Options.Verbosity >= VerbosityExhaustive {
+			return printer.Sprintf(", every month")
+		}
 		return ""
 	}
 	fnGetSingleItemDescription := func(printer *message.Printer, s string) string {
@@ -404,10 +624,10 @@ func (self *descriptor) getMonthDescription(entity *cronEntity) string {
 		if err != nil || month < 1 || month > 12 {
 			return ""
 		}
-		return MonthName[month]
+		return self.monthReference(month)
 	}
-	fnGetIntervalDescriptionFormat := func(printer *message.Printer, format, _ string) string {
-		return printer.Sprintf(", every %s months", format)
+	fnGetIntervalDescriptionFormat := func(_ *message.Printer, format, _ string) string {
+		return self.countf(", every %s months", format)
 	}
 	fnGetBetweenDescriptionFormat := func(printer *message.Printer, _ string, s ...string) string {
 		maxParams := 2
@@ -418,10 +638,13 @@ func (self *descriptor) getMonthDescription(entity *cronEntity) string {
 				break
 			}
 		}
-		return printer.Sprintf(", %s through %s", objectList...)
+		return self.sprintf(", %s through %s", objectList...)
 	}
 	fnGetDescriptionFormat := func(printer *message.Printer, _, s string) string {
-		return printer.Sprintf(", only in %s", s)
+		if self.Options.Verbosity <= VerbosityTerse {
+			return self.sprintf(", in %s", s)
+		}
+		return self.sprintf(", only in %s", s)
 	}
 
 	return self.getSegmentDescription(
@@ -453,23 +676,33 @@ func (self *descriptor) getDayOfMonthDescription(entity *cronEntity) string {
 				if dayNum == 1 {
 					dayString = self.Printer.Sprintf("first weekday")
 				} else {
-					dayString = self.Printer.Sprintf("weekday nearest day %s", strconv.Itoa(dayNum))
+					dayString = self.Printer.Sprintf("weekday nearest the %s", self.dayOrdinal(dayNum))
 				}
 			}
 			description = self.Printer.Sprintf(", on the %s of the month", dayString)
 
 		} else {
-			fnAllDescription := func(printer *message.Printer) string {
-				return printer.Sprintf(", every day")
+			fnAllDescription := func(_ *message.Printer) string {
+				return self.getEveryDayDescription(entity)
 			}
-			fnGetSingleItemDescription := func(_ *message.Printer, s string) string {
-				return s
+			//the items of a list each take the form of the day (i.e. "1-го и 15-го числа"),
+			//a single day takes it from the message
+			inList := strings.Contains(expression, ",") && !strings.Contains(expression, "/")
+			fnGetSingleItemDescription := func(printer *message.Printer, s string) string {
+				dayNum, err := strconv.Atoi(s)
+				if err != nil {
+					return s
+				}
+				if inList && locale.HasMessage(self.Options.Language, "%s (day in a list)") {
+					return printer.Sprintf("%s (day in a list)", self.dayOrdinal(dayNum))
+				}
+				return self.dayOrdinal(dayNum)
 			}
-			fnGetIntervalDescriptionFormat := func(printer *message.Printer, format, s string) string {
+			fnGetIntervalDescriptionFormat := func(_ *message.Printer, format, _ string) string {
 				if format == "1" {
-					return printer.Sprintf(", every day")
+					return self.getEveryDayDescription(entity)
 				} else {
-					return printer.Sprintf(", every %s days", s)
+					return self.countf(", every %s days", format)
 				}
 			}
 			fnGetBetweenDescriptionFormat := func(printer *message.Printer, _ string, s ...string) string {
@@ -481,10 +714,14 @@ func (self *descriptor) getDayOfMonthDescription(entity *cronEntity) string {
 						break
 					}
 				}
-				return printer.Sprintf(", between day %s and %s of the month", objectList...)
+				return printer.Sprintf(", between the %s and %s of the month", objectList...)
 			}
 			fnGetDescriptionFormat := func(printer *message.Printer, _, s string) string {
-				return printer.Sprintf(", on day %s of the month", s)
+				if inList && locale.HasMessage(self.Options.Language, ", on the %s days of the month") {
+					return printer.Sprintf(message.Key(", on the %s days of the month", ", on the %s of the month"), s)
+				}
+				//shares the English text with the weekday message but not the translations
+				return printer.Sprintf(message.Key(", on the %s day of the month", ", on the %s of the month"), s)
 			}
 			description = self.getSegmentDescription(
 				expression,
@@ -499,7 +736,29 @@ func (self *descriptor) getDayOfMonthDescription(entity *cronEntity) string {
 	return description
 }
 
+// getEveryDayDescription describes a day of the month field that does not restrict the days.
+// It goes without saying below the verbose level, and a restricted weekday field describes
+// the days instead.
+func (self *descriptor) getEveryDayDescription(entity *cronEntity) string {
+	if entity.DayOfWeek != "*" {
+		if self.Options.Verbosity >= VerbosityExhaustive {
+			return self.Printer.Sprintf(", on any day of the month")
+		}
+		return ""
+	}
+	if self.Options.Verbosity < VerbosityVerbose {
+		return ""
+	}
+	return self.Printer.Sprintf(", every day")
+}
+
 func (self *descriptor) getYearDescription(entity *cronEntity) string {
+	if entity.Year == "" || entity.Year == "*" {
+		if self.Options.Verbosity >= VerbosityExhaustive {
+			return self.Printer.Sprintf(", every year")
+		}
+		return ""
+	}
 
 	fnAllDescription := func(_ *message.Printer) string {
 		return ""
@@ -511,14 +770,14 @@ func (self *descriptor) getYearDescription(entity *cronEntity) string {
 			if err != nil {
 				return s
 			}
-			return strconv.Itoa(year)
+			return self.ssmlMark(ssmlYear, strconv.Itoa(year))
 
 		} else {
 			return s
 		}
 	}
-	fnGetIntervalDescriptionFormat := func(printer *message.Printer, format, _ string) string {
-		return printer.Sprintf(", every %s years", format)
+	fnGetIntervalDescriptionFormat := func(_ *message.Printer, format, _ string) string {
+		return self.countf(", every %s years", format)
 	}
 	fnGetBetweenDescriptionFormat := func(printer *message.Printer, _ string, s ...string) string {
 		maxParams := 2
@@ -532,16 +791,24 @@ func (self *descriptor) getYearDescription(entity *cronEntity) string {
 		return printer.Sprintf(", %s through %s", objectList...)
 	}
 	fnGetDescriptionFormat := func(printer *message.Printer, _, s string) string {
-		return printer.Sprintf(", only in %s", s)
+		//month and year share the English text but not the translations
+		if self.Options.Verbosity <= VerbosityTerse {
+			return printer.Sprintf(message.Key(", in year %s", ", in %s"), s)
+		}
+		return printer.Sprintf(message.Key(", only in year %s", ", only in %s"), s)
 	}
 
-	return self.getSegmentDescription(
+	description := self.getSegmentDescription(
 		entity.Year,
 		fnAllDescription,
 		fnGetSingleItemDescription,
 		fnGetIntervalDescriptionFormat,
 		fnGetBetweenDescriptionFormat,
 		fnGetDescriptionFormat)
+	if !self.Options.ReferenceTime.IsZero() {
+		description = self.getRelativeYearDescription(entity, description)
+	}
+	return description
 }
 
 func (self *descriptor) getSegmentDescription(
@@ -554,6 +821,7 @@ func (self *descriptor) getSegmentDescription(
 ) string {
 
 	description := ""
+	clauseSeparator := locale.ClauseSeparator(self.Options.Language)
 	if expression == "" {
 
 	} else if expression == "*" {
@@ -569,35 +837,22 @@ func (self *descriptor) getSegmentDescription(
 		//interval contains 'between' piece (i.e. 2-59/3 )
 		if strings.Contains(segments[0], "-") {
 			betweenSegmentDescription := self.generateBetweenSegmentDescription(segments[0], fnGetBetweenDescriptionFormat, fnGetSingleItemDescription)
-			if !regexp.MustCompile(`^(,\s)`).MatchString(betweenSegmentDescription) {
-				// not start with ", "
-				description += ", "
+			if !strings.HasPrefix(betweenSegmentDescription, clauseSeparator) {
+				description += clauseSeparator
 			}
 			description += betweenSegmentDescription
 
 		} else if !strings.ContainsAny(segments[0], "*,") {
 			rangeItemDescription := fnGetDescriptionFormat(self.Printer, segments[0], fnGetSingleItemDescription(self.Printer, segments[0]))
-			rangeItemDescription = strings.Replace(rangeItemDescription, ", ", "", -1)
+			rangeItemDescription = strings.Replace(rangeItemDescription, clauseSeparator, "", -1)
 			description += self.Printer.Sprintf(", starting %s", rangeItemDescription)
 		}
 
 	} else if strings.Contains(expression, ",") {
 		segments := strings.Split(expression, ",")
-		segmentsLength := len(segments)
-		descriptionContent := ""
-
-		for i, segment := range segments {
-			if i > 0 && segmentsLength > 2 {
-				descriptionContent += ","
-				if i < (segmentsLength - 1) {
-					descriptionContent += " "
-				}
-			}
-
-			if i > 0 && segmentsLength > 1 && (i == (segmentsLength-1) || segmentsLength == 2) {
-				descriptionContent += self.Printer.Sprintf(" and ")
-			}
+		itemList := make([]string, 0)
 
+		for _, segment := range segments {
 			if strings.Contains(segment, "-") {
 				betweenDescription := self.generateBetweenSegmentDescription(
 					segment,
@@ -610,18 +865,18 @@ func (self *descriptor) getSegmentDescription(
 								break
 							}
 						}
-						return printer.Sprintf(", %s through %s", objectList...)
+						return self.sprintf(", %s through %s", objectList...)
 					},
 					fnGetSingleItemDescription)
-				betweenDescription = strings.Replace(betweenDescription, ", ", "", -1)
-				descriptionContent += betweenDescription
+				betweenDescription = strings.Replace(betweenDescription, clauseSeparator, "", -1)
+				itemList = append(itemList, betweenDescription)
 
 			} else {
-				descriptionContent += fnGetSingleItemDescription(self.Printer, segment)
+				itemList = append(itemList, fnGetSingleItemDescription(self.Printer, segment))
 			}
 		}
 
-		description = fnGetDescriptionFormat(self.Printer, expression, descriptionContent)
+		description = fnGetDescriptionFormat(self.Printer, expression, self.joinList(itemList, self.Printer.Sprintf(", and ")))
 
 	} else if strings.Contains(expression, "-") {
 		description = self.generateBetweenSegmentDescription(expression, fnGetBetweenDescriptionFormat, fnGetSingleItemDescription)
@@ -639,12 +894,27 @@ func (self *descriptor) generateBetweenSegmentDescription(
 	betweenSegments := strings.Split(betweenExpression, "-")
 	betweenSegment1Description := fnGetSingleItemDescription(self.Printer, betweenSegments[0])
 	betweenSegment2Description := fnGetSingleItemDescription(self.Printer, betweenSegments[1])
-	betweenSegment2Description = strings.Replace(betweenSegment2Description, ":00", ":59", -1)
 
 	description += fnGetBetweenDescritionFormat(self.Printer, betweenExpression, betweenSegment1Description, betweenSegment2Description)
 	return description
 }
 
+// joinList joins the items with the separator of the locale and the last one with lastSeparator
+func (self *descriptor) joinList(itemList []string, lastSeparator string) string {
+	itemListLength := len(itemList)
+	switch itemListLength {
+	case 0:
+		return ""
+	case 1:
+		return itemList[0]
+	case 2:
+		return itemList[0] + self.Printer.Sprintf(" and ") + itemList[1]
+	default:
+		listSeparator := locale.ListSeparator(self.Options.Language)
+		return strings.Join(itemList[:itemListLength-1], listSeparator) + lastSeparator + itemList[itemListLength-1]
+	}
+}
+
 func (self *descriptor) formatTime(hourExp, minuteExp, secondsExp string) (string, error) {
 	hour, err := strconv.Atoi(hourExp)
 	if err != nil {
@@ -653,6 +923,7 @@ func (self *descriptor) formatTime(hourExp, minuteExp, secondsExp string) (strin
 	if hour < 0 || hour > 24 {
 		return "", errors.New("error hours")
 	}
+	hourOfDay := hour
 
 	period := ""
 	if !self.Options.Use24hourTimeFormat {
@@ -664,6 +935,9 @@ func (self *descriptor) formatTime(hourExp, minuteExp, secondsExp string) (strin
 
 		if hour > 12 {
 			hour -= 12
+		} else if hour == 0 {
+			//a 12-hour clock has no 00, midnight is 12 AM
+			hour = 12
 		}
 	}
 	hourExp = fmt.Sprintf("%02d", hour)
@@ -688,37 +962,96 @@ func (self *descriptor) formatTime(hourExp, minuteExp, secondsExp string) (strin
 		}
 	}
 
-	return fmt.Sprintf("%s:%s%s%s", hourExp, minuteExp, secondsExp, period), nil
-}
-
-func (self *descriptor) transformVerbosity(description string) string {
-	if !self.Options.Verbose {
-		description = strings.Replace(description, self.Printer.Sprintf(", every minute"), "", -1)
-		description = strings.Replace(description, self.Printer.Sprintf(", every hour"), "", -1)
-		description = strings.Replace(description, self.Printer.Sprintf(", every day"), "", -1)
+	timeOfDay := fmt.Sprintf("%s:%s%s%s", hourExp, minuteExp, secondsExp, period)
+	if self.Options.OutputFormat == FormatSSML {
+		//the speech engine says the time
+		if self.Options.Use24hourTimeFormat {
+			return self.ssmlMark(ssmlTime24, timeOfDay), nil
+		}
+		return self.ssmlMark(ssmlTime12, timeOfDay), nil
 	}
-	return description
+	if self.Options.Speakable {
+		seconds := strings.TrimPrefix(secondsExp, ":")
+		if _, ok := self.spokenTime(hourOfDay, minute, seconds, locale.Nominative); ok {
+			return self.timeReference(hourOfDay, minute, seconds), nil
+		}
+	}
+	return timeOfDay, nil
 }
 
 func (self *descriptor) transformCase(description string) string {
+	tag := locale.Tag(self.Options.Language)
+	//NoLower keeps upper case tokens such as AM and PM untouched
+	title := cases.Title(tag, cases.NoLower)
+
 	switch self.Options.CasingType {
 	case CasingSentence:
-		descriptionParts := strings.Split(description, " ")
-		descriptionParts[0] = strings.Title(descriptionParts[0])
-		description = strings.Join(descriptionParts, " ")
+		//only the first word is changed, the rest is left as generated
+		if loc := wordRegexp.FindStringIndex(description); loc != nil && startsWithLetter(description[loc[0]:loc[1]]) {
+			description = description[:loc[0]] + title.String(description[loc[0]:loc[1]]) + description[loc[1]:]
+		}
 
 	case CasingTitle:
-		description = strings.Title(description)
+		smallWords := make(map[string]bool)
+		for _, word := range locale.TitleSmallWords(self.Options.Language) {
+			smallWords[word] = true
+		}
+		isFirstWord := true
+		description = wordRegexp.ReplaceAllStringFunc(description, func(word string) string {
+			lowerWord := cases.Lower(tag).String(word)
+			if !isFirstWord && smallWords[lowerWord] {
+				return lowerWord
+			}
+			isFirstWord = false
+			if !startsWithLetter(word) {
+				return word
+			}
+			return title.String(word)
+		})
 
 	default:
-		description = strings.ToLower(description)
+		description = cases.Lower(tag).String(description)
 	}
 	return description
 }
 
+//numbers such as "1st" or "15." are words too but keep their case
+func startsWithLetter(word string) bool {
+	for _, r := range word {
+		return unicode.IsLetter(r)
+	}
+	return false
+}
+
+// transformDirection isolates the numbers and times of right to left descriptions
+// so they keep their left to right order, i.e. "10:15" inside Arabic text
+func (self *descriptor) transformDirection(description string) string {
+	if !locale.IsRightToLeft(self.Options.Language) {
+		return description
+	}
+	return numberRegexp.ReplaceAllStringFunc(description, func(s string) string {
+		//LEFT-TO-RIGHT ISOLATE ... POP DIRECTIONAL ISOLATE
+		return "\u2066" + s + "\u2069"
+	})
+}
+
+// transformDigits writes the digits with the numbering system of the locale
+func (self *descriptor) transformDigits(description string) string {
+	if !self.Options.NativeDigits {
+		return description
+	}
+	return digitsRegexp.ReplaceAllStringFunc(description, func(s string) string {
+		num, err := strconv.Atoi(s)
+		if err != nil {
+			return s
+		}
+		return self.Printer.Sprint(number.Decimal(num, number.NoSeparator(), number.MinIntegerDigits(len(s))))
+	})
+}
+
 func (self *descriptor) numberToDay(dayNumber int) string {
 	if dayNumber < 0 || dayNumber >= len(WeekDayName) {
 		return ""
 	}
-	return self.Printer.Sprintf(WeekDayName[dayNumber])
+	return self.dayName(dayNumber, locale.Nominative)
 }
@@ -2,10 +2,15 @@ package main
 
 import (
 	"cron-descriptor/locale"
+	"flag"
 	"fmt"
 	"testing"
+	"time"
+	"unicode/utf8"
 )
 
+var maxShortLength = flag.Int("short-length", 60, "longest short or compact description allowed, in characters")
+
 func TestDefault(t *testing.T) {
 	cron := "0 15 10 * 6L 2002-2006"
 
@@ -86,3 +91,383 @@ func TestDescriptor_GetDescription(t *testing.T) {
 		fmt.Printf("%s:: \n %s \n\n", val, d)
 	}
 }
+
+func TestDescriptor_transformCase(t *testing.T) {
+	testList := []struct {
+		casingType  int
+		description string
+		expected    string
+	}{
+		{CasingSentence, "at 10:15 AM, on the last Saturday of the month", "At 10:15 AM, on the last Saturday of the month"},
+		{CasingTitle, "at 10:15 AM, on the last Saturday of the month", "At 10:15 AM, on the Last Saturday of the Month"},
+		{CasingTitle, "the job's hour, only in March", "The Job's Hour, Only in March"},
+		{CasingLowerCase, "At 10:15 AM, only in March", "at 10:15 am, only in march"},
+		{CasingSentence, "1st & 15th, 00:00", "1st & 15th, 00:00"},
+		{CasingTitle, "at noon, on the 3rd Saturday of the month", "At Noon, on the 3rd Saturday of the Month"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.CasingType = val.casingType
+		desc := NewDescriptor("", opts)
+		if d := desc.transformCase(val.description); d != val.expected {
+			t.Errorf("casing %d of %q: expected %q, got %q", val.casingType, val.description, val.expected, d)
+		}
+	}
+}
+
+func TestDescriptor_GetDescription_summarize(t *testing.T) {
+	testList := []struct {
+		cron     string
+		expected string
+		literal  string
+	}{
+		{"0 9-17 * * 1-5", "Every hour during business hours on weekdays", "Every hour, between 09:00 AM and 05:00 PM, Monday through Friday"},
+		{"0 0 * * SAT,SUN", "At midnight on weekends", "At 12:00 AM, only on Saturday and Sunday"},
+		{"*/15 * * * *", "Every quarter hour", "Every 15 minutes"},
+		{"0 0 1 1,4,7,10 *", "Quarterly", "At 12:00 AM, on the 1st of the month, only in January, April, July, and October"},
+		{"0 0 2 1,4,7,10 *", "At midnight, on the 2nd of the month, only in January, April, July, and October", "At 12:00 AM, on the 2nd of the month, only in January, April, July, and October"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.expected {
+			t.Errorf("%s: expected %q, got %q", val.cron, val.expected, desc)
+		}
+
+		opts.Summarize = false
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.literal {
+			t.Errorf("%s without summaries: expected %q, got %q", val.cron, val.literal, desc)
+		}
+	}
+}
+
+func TestDescriptor_GetDescription_verbosity(t *testing.T) {
+	testList := []struct {
+		language  int
+		verbosity int
+		cron      string
+		expected  string
+	}{
+		{locale.EN_US, VerbosityTerse, "0 12 ? 3 WED", "At noon, on Wednesday, in March"},
+		{locale.EN_US, VerbosityNormal, "0 12 ? 3 WED", "At noon, only on Wednesday, only in March"},
+		{locale.EN_US, VerbosityVerbose, "0 12 ? 3 WED", "At noon, only on Wednesday, only in March"},
+		{locale.EN_US, VerbosityExhaustive, "0 12 ? 3 WED", "At 12:00 PM, on any day of the month, only on Wednesday, only in March, every year"},
+		{locale.EN_US, VerbosityTerse, "0 15 10 * * ? 2005", "At 10:15 AM, in 2005"},
+		{locale.EN_US, VerbosityNormal, "*/5 * * * * *", "Every 5 seconds"},
+		{locale.EN_US, VerbosityVerbose, "*/5 * * * * *", "Every 5 seconds, every minute, every hour, every day"},
+		{locale.EN_US, VerbosityExhaustive, "* * * * *", "Every minute, every hour, every day, every month, every year"},
+		{locale.EN_US, VerbosityExhaustive, "0 15 10 15 * ?", "At 10:15 AM, on the 15th of the month, on any day of the week, every month, every year"},
+		{locale.DE_DE, VerbosityTerse, "0 12 ? 3 WED", "Mittags, mittwochs, im März"},
+		{locale.DE_DE, VerbosityVerbose, "0 12 * * ?", "Mittags, jeden Tag"},
+		{locale.RU_RU, VerbosityTerse, "0 12 ? 3 WED", "В полдень, по средам, в марте"},
+		{locale.FR_FR, VerbosityTerse, "0 12 ? 3 WED", "À midi, les mercredis, en mars"},
+		{locale.JA_JP, VerbosityExhaustive, "* * * * *", "毎分、毎時、毎日、毎月、毎年"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		opts.Verbosity = val.verbosity
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.expected {
+			t.Errorf("%s (%d, %d): expected %q, got %q", val.cron, val.language, val.verbosity, val.expected, desc)
+		}
+	}
+}
+
+func TestDescriptor_GetDescription_spellOut(t *testing.T) {
+	testList := []struct {
+		language int
+		below    int
+		cron     string
+		expected string
+	}{
+		{locale.EN_US, 10, "0 */2 * * *", "Every two hours"},
+		{locale.EN_US, 10, "0 0 1,3 * *", "At midnight, on the first and third of the month"},
+		{locale.EN_US, 10, "0 10 ? * 1#3", "At 10:00 AM, on the third Monday of the month"},
+		{locale.EN_US, 10, "0 0 0 2/3 * ?", "At midnight, every three days, starting on the second of the month"},
+		{locale.EN_US, 10, "0 5 8 * * ? 2005", "At 08:05 AM, only in 2005"},
+		{locale.EN_US, 10, "*/15 * * * * *", "Every 15 seconds"},
+		{locale.EN_US, 3, "*/3 * * * *", "Every 3 minutes"},
+		{locale.DE_DE, 10, "0 10 ? * 0#1", "Um 10:00 AM, am ersten Sonntag des Monats"},
+		{locale.FR_FR, 10, "0 10 ? * 0#1", "À 10:00 AM, le premier dimanche du mois"},
+		{locale.PT_BR, 10, "0 */2 * * *", "A cada duas horas"},
+		{locale.PT_BR, 10, "0 10 ? * 3#2", "Às 10:00 AM, na segunda quarta-feira do mês"},
+		{locale.RU_RU, 10, "*/2 * * * *", "Каждые две минуты"},
+		{locale.RU_RU, 10, "0 */2 * * *", "Каждые два часа"},
+		{locale.RU_RU, 0, "*/21 * * * *", "Каждую 21 минуту"},
+		{locale.RU_RU, 0, "0 0 */5 * ?", "В полночь, каждые 5 дней"},
+		{locale.PL_PL, 10, "0 10 ? * 0#1", "O 10:00 AM, w pierwszą niedzielę miesiąca"},
+		{locale.ZH_CN, 10, "0 */2 * * *", "每两小时"},
+		{locale.AR_SA, 10, "*/3 * * * *", "كل ⁦3⁩ دقائق"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		opts.SpellOutBelow = val.below
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.expected {
+			t.Errorf("%s (%d, below %d): expected %q, got %q", val.cron, val.language, val.below, val.expected, desc)
+		}
+	}
+}
+
+func TestDescriptor_GetDescription_nthDays(t *testing.T) {
+	testList := []struct {
+		language int
+		cron     string
+		expected string
+	}{
+		{locale.EN_US, "0 17 * * 1#1,1#3,3#1,3#3", "At 05:00 PM, on the 1st and 3rd Monday and Wednesday of the month"},
+		{locale.EN_US, "0 17 * * 1#1,6#2", "At 05:00 PM, on the 1st Monday of the month, on the 2nd Saturday of the month"},
+		{locale.DE_DE, "0 17 * * 1#1,1#3,3#1,3#3", "Um 05:00 PM, am 1. und 3. Montag und Mittwoch des Monats"},
+		{locale.RU_RU, "0 17 * * 1#1,1#3,3#1,3#3", "В 05:00 PM, в 1-й и 3-й понедельник месяца, в 1-ю и 3-ю среду месяца"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.expected {
+			t.Errorf("%s (%d): expected %q, got %q", val.cron, val.language, val.expected, desc)
+		}
+	}
+}
+
+func TestDescriptor_GetDescription_speakable(t *testing.T) {
+	testList := []struct {
+		language int
+		style    int
+		cron     string
+		expected string
+	}{
+		{locale.EN_US, StyleLong, "0 15 10 * * ?", "At ten fifteen a.m."},
+		{locale.EN_US, StyleLong, "0 5 14 * * ?", "At two oh five p.m."},
+		{locale.EN_US, StyleShort, "*/5 9-17 * * MON-FRI", "Every five minutes, between nine o'clock a.m. and five fifty-five p.m. on weekdays"},
+		{locale.EN_US, StyleLong, "0 0 13 1,21 * ?", "At one o'clock p.m., on the first and twenty-first of the month"},
+		{locale.EN_US, StyleLong, "20-40 * * * *", "Minutes twenty through forty past the hour"},
+		{locale.DE_DE, StyleLong, "0 0 13 * * ?", "Um ein Uhr nachmittags"},
+		{locale.FR_FR, StyleLong, "0 15 10 * * ?", "À dix heures quinze du matin"},
+		{locale.PT_BR, StyleLong, "0 0 14 * * ?", "Às duas horas da tarde"},
+		{locale.RU_RU, StyleLong, "0 0 7-23/2 * * *", "Каждые два часа, с семи часов утра до одиннадцати часов вечера"},
+		{locale.RU_RU, StyleLong, "0 30 21 * * ?", "В девять тридцать вечера"},
+		{locale.RU_RU, StyleLong, "0 0-5 14 * * ?", "Каждую минуту с двух часов дня по два ноль пять дня"},
+		{locale.PL_PL, StyleLong, "0 15 10 * * ?", "O dziesiątej piętnaście rano"},
+		{locale.ZH_CN, StyleLong, "0 0 14 * * ?", "在下午两点"},
+		{locale.KO_KR, StyleLong, "0 15 10 * * ?", "오전 열 시 십오 분에"},
+		{locale.KO_KR, StyleLong, "0 0 */2 * * ?", "두시간마다"},
+		{locale.KO_KR, StyleLong, "*/5 9-17 * * *", "오분마다, 오전 아홉 시부터 오후 다섯 시 오십오 분까지"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		opts.DescriptionStyle = val.style
+		opts.Speakable = true
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.expected {
+			t.Errorf("%s (%d): expected %q, got %q", val.cron, val.language, val.expected, desc)
+		}
+	}
+}
+
+// Arabic and Hebrew have no number words yet (see locale/number.go), their speakable
+// descriptions keep the digits of the times and counts
+func TestDescriptor_GetDescription_speakableWithoutWords(t *testing.T) {
+	testList := []struct {
+		language int
+		cron     string
+		expected string
+	}{
+		{locale.AR_SA, "0 15 10 * * ?", "في الساعة \u206610:15 AM\u2069"},
+		{locale.HE_IL, "0 15 10 * * ?", "ב-\u206610:15 AM\u2069"},
+		{locale.HE_IL, "0 0 */2 * * ?", "כל \u20662\u2069 שעות"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		opts.Speakable = true
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.expected {
+			t.Errorf("%s (%d): expected %q, got %q", val.cron, val.language, val.expected, desc)
+		}
+	}
+}
+
+func TestDescriptor_GetDescription_reference(t *testing.T) {
+	ref := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	testList := []struct {
+		language int
+		cron     string
+		expected string
+	}{
+		{locale.EN_US, "0 15 10 * * ? 2002-2005", "At 10:15 AM, 2002 through 2005 (expired — last ran in 2005)"},
+		{locale.EN_US, "0 15 10 * * ? 2027/2", "At 10:15 AM, every 2 years, starting in 2027"},
+		{locale.EN_US, "0 0 0 1 1 ? 2027/2", "At midnight, on the 1st of the month, only in January, every 2 years, starting in 2027"},
+		{locale.EN_US, "0 15 10 * * ? 2027-2030", "At 10:15 AM, starting in 2027, until 2030"},
+		{locale.EN_US, "0 15 10 * * ? 2028-2034/3", "At 10:15 AM, every 3 years, starting in 2028, until 2034"},
+		{locale.EN_US, "0 15 10 * * ? 2027", "At 10:15 AM, next year only"},
+		{locale.EN_US, "0 15 10 * * ? 2029", "At 10:15 AM, only in 2029"},
+		{locale.EN_US, "0 15 10 * * ? 2027,2029", "At 10:15 AM, only in 2027 and 2029"},
+		{locale.EN_US, "0 15 10 * * ? 2026", "At 10:15 AM, this year only"},
+		{locale.EN_US, "0 15 10 1-5 1 ? 2026", "At 10:15 AM, between the 1st and 5th of the month, only in January, only in 2026 (expired — last ran in 2026)"},
+		{locale.EN_US, "0 15 10 * * ? 2020-2030", "At 10:15 AM, 2020 through 2030"},
+		{locale.DE_DE, "0 15 10 * * ? 2002-2005", "Um 10:15 AM, 2002 bis 2005 (abgelaufen — zuletzt 2005 ausgeführt)"},
+		{locale.RU_RU, "0 15 10 * * ? 2027/2", "В 10:15 AM, каждые 2 года, начиная с 2027 года"},
+		{locale.RU_RU, "0 15 10 * * ? 2027-2030", "В 10:15 AM, начиная с 2027 года по 2030 год"},
+		{locale.DE_DE, "0 15 10 * * ? 2027", "Um 10:15 AM, nur nächstes Jahr"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		opts.ReferenceTime = ref
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.expected {
+			t.Errorf("%s (%d): expected %q, got %q", val.cron, val.language, val.expected, desc)
+		}
+	}
+}
+
+func TestDescriptor_GetDescription_examples(t *testing.T) {
+	ref := time.Date(2025, time.October, 20, 23, 0, 0, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*60*60)
+	testList := []struct {
+		language int
+		location *time.Location
+		count    int
+		cron     string
+		expected string
+	}{
+		{locale.EN_US, nil, 2, "0 15 10 ? * 6L",
+			"At 10:15 AM, on the last Saturday of the month, e.g. Sat 25 Oct 10:15 AM, Sat 29 Nov 10:15 AM"},
+		{locale.EN_US, nil, 9, "0 0 12 1 1 ? 2026-2027",
+			"At noon, on the 1st of the month, only in January, starting in 2026, until 2027, e.g. Thu 1 Jan 2026 12:00 PM, Fri 1 Jan 2027 12:00 PM"},
+		{locale.EN_US, tokyo, 1, "0 30 7 * * ?", "At 07:30 AM, e.g. Wed 22 Oct 07:30 AM"},
+		{locale.DE_DE, nil, 1, "0 0 12 LW * ?", "Mittags, am letzten Werktag des Monats, z. B. Fr 31. Okt 12:00 PM"},
+		{locale.RU_RU, nil, 1, "0 0 12 LW * ?", "В полдень, в последний будний день месяца, например: пт 31 октября 12:00 PM"},
+		{locale.JA_JP, nil, 1, "0 0 12 LW * ?", "正午に、毎月最終平日、例：10月31日(金) 12:00 PM"},
+		{locale.EN_US, nil, 0, "0 0 12 LW * ?", "At noon, on the last weekday of the month"},
+		{locale.EN_US, nil, 3, "0 0 12 1 1 ? 2020", "At noon, on the 1st of the month, only in January, only in 2020 (expired — last ran in 2020)"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		opts.ReferenceTime = ref
+		opts.TimeZone = val.location
+		opts.ExampleCount = val.count
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.expected {
+			t.Errorf("%s (%d): expected %q, got %q", val.cron, val.language, val.expected, desc)
+		}
+	}
+
+	//the occurrences of L-2 cannot be worked out, the description goes without examples
+	opts := NewDefaultOptions()
+	expected := NewDescriptor("0 0 12 L-2 * ?", opts).GetDescription()
+	opts.ReferenceTime = ref
+	opts.ExampleCount = 2
+	if desc := NewDescriptor("0 0 12 L-2 * ?", opts).GetDescription(); desc != expected {
+		t.Errorf("expected %q, got %q", expected, desc)
+	}
+}
+
+func TestDescriptor_GetDescription_dialect(t *testing.T) {
+	testList := []struct {
+		dialect  int
+		style    int
+		language int
+		cron     string
+		expected string
+	}{
+		{DialectAnd, StyleLong, locale.EN_US, "0 0 1 * MON", "At midnight, on the 1st of the month, only on Monday"},
+		{DialectVixie, StyleLong, locale.EN_US, "0 0 1 * MON", "At midnight, on the 1st of the month or on any Monday"},
+		{DialectVixie, StyleLong, locale.EN_US, "0 0 1,15 * 1-5", "At midnight, on the 1st and 15th of the month or Monday through Friday"},
+		{DialectVixie, StyleLong, locale.EN_US, "0 0 */2 * MON", "At midnight, every 2 days, only on Monday"},
+		{DialectVixie, StyleShort, locale.EN_US, "0 9 L * 3", "Last day or Wed, 09:00 AM"},
+		{DialectVixie, StyleLong, locale.DE_DE, "0 0 13 * 5", "Um Mitternacht, am 13. des Monats oder an jedem Freitag"},
+		{DialectVixie, StyleLong, locale.RU_RU, "0 9 L * 3", "В 09:00 AM, в последний день месяца или в любую среду"},
+		{DialectQuartz, StyleLong, locale.EN_US, "0 0 1 * MON", "day of month and day of week cannot both be specified"},
+		{DialectQuartz, StyleLong, locale.EN_US, "0 0 9 1 * ?", "At 09:00 AM, on the 1st of the month"},
+		{unuseDialect, StyleLong, locale.EN_US, "0 0 1 * MON", "At midnight, on the 1st of the month or on any Monday"},
+		{unuseDialect, StyleLong, locale.EN_US, "0 0 0 1 * MON", "At midnight, on the 1st of the month, only on Monday"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Dialect = val.dialect
+		opts.DescriptionStyle = val.style
+		opts.Language = val.language
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.expected {
+			t.Errorf("%s (%d): expected %q, got %q", val.cron, val.dialect, val.expected, desc)
+		}
+	}
+}
+
+func TestDescriptor_IsExpired(t *testing.T) {
+	ref := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	testList := []struct {
+		cron     string
+		expected bool
+	}{
+		{"0 15 10 * * ? 2002-2005", true},
+		{"0 15 10 * * ? 2025", true},
+		{"0 15 10 * * ? 2026", false},
+		{"0 0 0 1 1 ? 2026", true},
+		{"0 15 10 * * ? 2020/2", false},
+		{"0 15 10 * * ?", false},
+		{"0 15 10 * * ? *", false},
+	}
+
+	for _, val := range testList {
+		expired, err := NewDescriptor(val.cron, NewDefaultOptions()).IsExpired(ref)
+		if err != nil {
+			t.Errorf("%s: %v", val.cron, err)
+		} else if expired != val.expected {
+			t.Errorf("%s: expected expired %v, got %v", val.cron, val.expected, expired)
+		}
+	}
+}
+
+func TestDescriptor_GetDescription_style(t *testing.T) {
+	testList := []struct {
+		language int
+		style    int
+		cron     string
+		expected string
+	}{
+		{locale.EN_US, StyleShort, "0 0 0 1,15 * ?", "1st & 15th, 12:00 AM"},
+		{locale.EN_US, StyleShort, "0 15 10 ? * 6L 2002-2005", "Last Sat, 2002–2005, 10:15 AM"},
+		{locale.EN_US, StyleCompact, "0 15 10 ? * MON-FRI", "Mon–Fri 10:15 AM"},
+		{locale.EN_US, StyleCompact, "0 0/30 9-17 * * ?", "Every 30 min 09:00 AM–05:30 PM"},
+		{locale.EN_US, StyleCompact, "0 0 12 ? 1-3 MON,WED", "Mon & Wed Jan–Mar 12:00 PM"},
+		{locale.DE_DE, StyleCompact, "0 15 10 ? * MON-FRI", "Mo–Fr 10:15"},
+		{locale.DE_DE, StyleShort, "0 0 0 1,15 * ?", "1. & 15., 00:00"},
+		{locale.RU_RU, StyleCompact, "0 0 12 ? 1-3 MON,WED", "Пн и ср янв.–март 12:00"},
+		{locale.JA_JP, StyleCompact, "0 15 10 ? * 6#3", "第3土曜 10:15"},
+		{locale.ZH_CN, StyleShort, "0 15 10 ? * MON-FRI", "周一至周五，10:15"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		opts.DescriptionStyle = val.style
+		opts.Use24hourTimeFormat = val.language != locale.EN_US
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.expected {
+			t.Errorf("%s (locale %d, style %d): expected %q, got %q", val.cron, val.language, val.style, val.expected, desc)
+		}
+	}
+}
+
+func TestDescriptor_GetDescription_shortLength(t *testing.T) {
+	for name, language := range goldenLocaleList {
+		for _, style := range []int{StyleShort, StyleCompact} {
+			opts := NewDefaultOptions()
+			opts.Language = language
+			opts.DescriptionStyle = style
+			for _, cron := range goldenCronList {
+				desc := NewDescriptor(cron, opts).GetDescription()
+				if length := utf8.RuneCountInString(desc); length > *maxShortLength {
+					t.Errorf("%s (%s, style %d): %q is %d characters long, more than %d", cron, name, style, desc, length, *maxShortLength)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"errors"
+	"strings"
+)
+
+// Cron dialects disagree on an expression restricting both the day of the month
+// and the day of the week. With DialectAnd a day must match both fields, Vixie
+// and POSIX cron run on the days matching either (i.e. "0 0 1 * MON" runs on the
+// 1st and on every Monday) and Quartz rejects the expression. Options without a
+// dialect leave it to the expression, a 5-field one is a Unix one.
+
+// dayFieldRestricted reports whether a day field restricts the days the Vixie way,
+// a field starting with an asterisk (i.e. */2) does not
+func dayFieldRestricted(expression string) bool {
+	return !strings.HasPrefix(expression, "*")
+}
+
+// dialectOf is the dialect the expression follows, the one of the options or without one
+// Vixie for a 5-field expression and DialectAnd for one with seconds or years
+func dialectOf(entity *cronEntity, opts *options) int {
+	if opts.Dialect != unuseDialect {
+		return opts.Dialect
+	}
+	_, hasSeconds := entity.Spans[FieldSeconds]
+	_, hasYear := entity.Spans[FieldYear]
+	if hasSeconds || hasYear {
+		return DialectAnd
+	}
+	return DialectVixie
+}
+
+// daysEitherMatch reports whether the expression runs on the days matching either
+// day field rather than both
+func daysEitherMatch(entity *cronEntity, opts *options) bool {
+	return dialectOf(entity, opts) == DialectVixie &&
+		dayFieldRestricted(entity.DayOfMonth) && dayFieldRestricted(entity.DayOfWeek)
+}
+
+// checkDialect rejects what the dialect of the options does not allow
+func checkDialect(entity *cronEntity, opts *options) error {
+	if opts.Dialect == DialectQuartz && entity.DayOfMonth != "*" && entity.DayOfWeek != "*" {
+		return errors.New("day of month and day of week cannot both be specified")
+	}
+	return nil
+}
+
+// joinEitherDays describes the two day fields as alternatives,
+// i.e. "on the 1st of the month or on any Monday"
+func (self *descriptor) joinEitherDays(entity *cronEntity, dayOfMonth, dayOfWeek Segment) Segment {
+	dayOfWeekText := segmentPrefixRegexp.ReplaceAllString(dayOfWeek.Text, "")
+	text := self.Printer.Sprintf("%s or %s", dayOfMonth.Text, dayOfWeekText)
+	return newSegment(entity, dayOfMonth.Kind, text, FieldDayOfMonth, FieldDayOfWeek)
+}
@@ -0,0 +1,9 @@
+package main
+
+const (
+	//DialectEnum
+	unuseDialect = iota
+	DialectAnd
+	DialectVixie
+	DialectQuartz
+)
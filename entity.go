@@ -1,11 +1,36 @@
 package main
 
 type cronEntity struct {
-	Seconds    string `json:"seconds"`
-	Minutes    string `json:"minutes"`
-	Hours      string `json:"hours"`
-	DayOfMonth string `json:"dayOfMonth"`
-	Month      string `json:"month"`
-	DayOfWeek  string `json:"dayOfWeek"`
-	Year       string `json:"year"`
+	Seconds    string       `json:"seconds"`
+	Minutes    string       `json:"minutes"`
+	Hours      string       `json:"hours"`
+	DayOfMonth string       `json:"dayOfMonth"`
+	Month      string       `json:"month"`
+	DayOfWeek  string       `json:"dayOfWeek"`
+	Year       string       `json:"year"`
+	Spans      map[int]Span `json:"-"`
+	//the token of each field that does not parse, empty when the field is missing
+	//(see recover.go)
+	Invalid map[int]string `json:"-"`
+}
+
+func (self *cronEntity) field(field int) string {
+	switch field {
+	case FieldSeconds:
+		return self.Seconds
+	case FieldMinutes:
+		return self.Minutes
+	case FieldHours:
+		return self.Hours
+	case FieldDayOfMonth:
+		return self.DayOfMonth
+	case FieldMonth:
+		return self.Month
+	case FieldDayOfWeek:
+		return self.DayOfWeek
+	case FieldYear:
+		return self.Year
+	default:
+		return ""
+	}
 }
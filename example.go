@@ -0,0 +1,119 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// With the ExampleCount option and a reference time the description ends with
+// the next occurrences after the reference time, i.e. "e.g. Fri 31 Oct 10:15 AM",
+// which say more than "on the last weekday of the month" to most readers.
+
+// maxExampleCount caps the ExampleCount option
+const maxExampleCount = 5
+
+var datePatternRegexp = regexp.MustCompile(`EEE|MMMM|MMM|M|yyyy|d`)
+
+// getExampleSegment lists the next occurrences after the reference time, in the time zone
+// of the options. ok is false when there is nothing to list, or when the occurrences cannot
+// be worked out: the examples are an aside and do not fail the description.
+func (self *descriptor) getExampleSegment(entity *cronEntity) (Segment, bool) {
+	count := self.Options.ExampleCount
+	if count <= 0 || self.Options.ReferenceTime.IsZero() {
+		return Segment{}, false
+	}
+	if count > maxExampleCount {
+		count = maxExampleCount
+	}
+
+	ref := self.Options.ReferenceTime
+	if self.Options.TimeZone != nil {
+		ref = ref.In(self.Options.TimeZone)
+	}
+	occurrenceList, err := self.Occurrences(ref, count)
+	if err != nil || len(occurrenceList) == 0 {
+		return Segment{}, false
+	}
+
+	itemList := make([]string, 0)
+	for _, occurrence := range occurrenceList {
+		example, err := self.formatExample(entity, occurrence, occurrence.Year() != ref.Year())
+		if err != nil {
+			return Segment{}, false
+		}
+		itemList = append(itemList, example)
+	}
+	text := self.Printer.Sprintf(", e.g. %s", strings.Join(itemList, locale.ListSeparator(self.Options.Language)))
+	return newSegment(entity, SegmentExample, text), true
+}
+
+// formatExample writes an occurrence with the date pattern of the locale and the time
+// of day the way the description does
+func (self *descriptor) formatExample(entity *cronEntity, occurrence time.Time, withYear bool) (string, error) {
+	language := self.Options.Language
+	if self.Options.OutputFormat == FormatSSML {
+		//the speech engine says the date after the day name
+		kind, date := ssmlMonthDay, occurrence.Format("01-02")
+		if withYear {
+			kind, date = ssmlDate, occurrence.Format("2006-01-02")
+		}
+		timeOfDay, err := self.formatTime(strconv.Itoa(occurrence.Hour()), strconv.Itoa(occurrence.Minute()), self.exampleSeconds(entity, occurrence))
+		if err != nil {
+			return "", err
+		}
+		return self.dayName(int(occurrence.Weekday()), locale.Nominative) + " " + self.ssmlMark(kind, date) + " " + timeOfDay, nil
+	}
+	date := datePatternRegexp.ReplaceAllStringFunc(locale.DatePattern(language, withYear), func(token string) string {
+		switch token {
+		case "EEE":
+			return self.exampleDayName(int(occurrence.Weekday()))
+		case "MMMM":
+			return self.monthName(int(occurrence.Month()), locale.Genitive)
+		case "MMM":
+			return self.exampleMonthName(int(occurrence.Month()))
+		case "M":
+			return strconv.Itoa(int(occurrence.Month()))
+		case "yyyy":
+			return strconv.Itoa(occurrence.Year())
+		default:
+			return strconv.Itoa(occurrence.Day())
+		}
+	})
+
+	timeOfDay, err := self.formatTime(strconv.Itoa(occurrence.Hour()), strconv.Itoa(occurrence.Minute()), self.exampleSeconds(entity, occurrence))
+	if err != nil {
+		return "", err
+	}
+	return date + " " + timeOfDay, nil
+}
+
+// exampleSeconds returns the seconds of an occurrence when the expression has a seconds field
+func (self *descriptor) exampleSeconds(entity *cronEntity, occurrence time.Time) string {
+	if entity.Seconds == "" {
+		return ""
+	}
+	return strconv.Itoa(occurrence.Second())
+}
+
+// exampleDayName returns the abbreviated day name, speakable descriptions say it in full
+func (self *descriptor) exampleDayName(dayNumber int) string {
+	if !self.Options.Speakable {
+		if name, ok := locale.DayAbbreviation(self.Options.Language, dayNumber); ok {
+			return name
+		}
+	}
+	return self.dayName(dayNumber, locale.Nominative)
+}
+
+// exampleMonthName returns the abbreviated month name, speakable descriptions say it in full
+func (self *descriptor) exampleMonthName(monthNumber int) string {
+	if !self.Options.Speakable {
+		if name, ok := locale.MonthAbbreviation(self.Options.Language, monthNumber); ok {
+			return name
+		}
+	}
+	return self.monthName(monthNumber, locale.Genitive)
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"strconv"
+
+	"golang.org/x/text/message"
+)
+
+// The explanation breaks an expression down field by field: the token as written,
+// the values the field allows and what the token means on its own (i.e. "*/15",
+// "every 15 minutes"), for a table in documentation or a tooltip in an editor.
+// Every field is explained, even where it goes without saying in the description.
+
+var (
+	fieldLabelList = map[int]message.Reference{
+		FieldSeconds:    message.Key("second (field)", "second"),
+		FieldMinutes:    message.Key("minute (field)", "minute"),
+		FieldHours:      message.Key("hour (field)", "hour"),
+		FieldDayOfMonth: message.Key("day of month (field)", "day of month"),
+		FieldMonth:      message.Key("month (field)", "month"),
+		FieldDayOfWeek:  message.Key("day of week (field)", "day of week"),
+		FieldYear:       message.Key("year (field)", "year"),
+	}
+
+	fieldRangeList = map[int]string{
+		FieldSeconds:    "0-59",
+		FieldMinutes:    "0-59",
+		FieldHours:      "0-23",
+		FieldDayOfMonth: "1-31",
+		FieldMonth:      "1-12, JAN-DEC",
+		FieldDayOfWeek:  "0-6, SUN-SAT",
+		FieldYear:       "1970-" + strconv.Itoa(lastYear),
+	}
+)
+
+// FieldExplanation is the row of a field in the explanation of an expression
+type FieldExplanation struct {
+	Field       int
+	Token       string
+	Name        string
+	Range       string
+	Explanation string
+	Span        Span
+}
+
+// Explain returns a row for each field of the expression, in the order they are written
+func (self *descriptor) Explain() ([]FieldExplanation, error) {
+	entity, err := parse(self)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := *self.Options
+	opts.Verbosity = VerbosityExhaustive
+	explainer := &descriptor{Expression: self.Expression, Printer: self.Printer, Options: &opts}
+
+	rowList := make([]FieldExplanation, 0)
+	for field := FieldSeconds; field <= FieldYear; field++ {
+		span, ok := entity.Spans[field]
+		if !ok {
+			continue
+		}
+		explanation := locale.Euphony(self.Options.Language, self.resolveNames(explainer.explainField(entity, field), locale.Nominative))
+		explanation = segmentPrefixRegexp.ReplaceAllString(explanation, "")
+		rowList = append(rowList, FieldExplanation{
+			Field:       field,
+			Token:       self.Expression[span.Start:span.End],
+			Name:        self.Printer.Sprintf(fieldLabelList[field]),
+			Range:       self.fieldRange(field),
+			Explanation: self.transformDigits(self.transformDirection(explanation)),
+			Span:        span,
+		})
+	}
+	return rowList, nil
+}
+
+// explainField describes a field on its own with the describer of the field
+func (self *descriptor) explainField(entity *cronEntity, field int) string {
+	switch field {
+	case FieldSeconds:
+		if entity.Seconds == "" {
+			//the parser drops a zero second
+			return self.Printer.Sprintf("at %s seconds past the minute", self.speakNumber("0"))
+		}
+		return self.getSecondsDescription(entity)
+	case FieldMinutes:
+		if entity.Minutes == "0" {
+			//the describer leaves the top of the hour to the hours
+			return self.Printer.Sprintf("at %s minutes past the hour", self.speakNumber(entity.Minutes))
+		}
+		return self.getMinutesDescription(entity)
+	case FieldHours:
+		return self.getHoursDescription(entity)
+	case FieldDayOfMonth:
+		if entity.DayOfMonth == "*" || entity.DayOfMonth == "?" {
+			//whatever the day of week field says
+			return self.Printer.Sprintf(", on any day of the month")
+		}
+		return self.getDayOfMonthDescription(entity)
+	case FieldMonth:
+		return self.getMonthDescription(entity)
+	case FieldDayOfWeek:
+		if entity.DayOfWeek == "*" {
+			return self.Printer.Sprintf(", on any day of the week")
+		}
+		return self.getDayOfWeekDescription(entity)
+	case FieldYear:
+		return self.getYearDescription(entity)
+	default:
+		return ""
+	}
+}
+
+// fieldRange returns the values a field allows, the days of the week are numbered
+// the way the options say
+func (self *descriptor) fieldRange(field int) string {
+	if field == FieldDayOfWeek && !self.Options.DayOfWeekStartIndexZero {
+		return "1-7, SUN-SAT"
+	}
+	return fieldRangeList[field]
+}
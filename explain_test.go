@@ -0,0 +1,79 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"testing"
+)
+
+func TestDescriptor_Explain(t *testing.T) {
+	testList := []struct {
+		language int
+		cron     string
+		expected []FieldExplanation
+	}{
+		{locale.EN_US, "*/15 9-17 * * MON-FRI", []FieldExplanation{
+			{FieldMinutes, "*/15", "minute", "0-59", "every 15 minutes", Span{0, 4}},
+			{FieldHours, "9-17", "hour", "0-23", "between 09:00 AM and 05:00 PM", Span{5, 9}},
+			{FieldDayOfMonth, "*", "day of month", "1-31", "on any day of the month", Span{10, 11}},
+			{FieldMonth, "*", "month", "1-12, JAN-DEC", "every month", Span{12, 13}},
+			{FieldDayOfWeek, "MON-FRI", "day of week", "0-6, SUN-SAT", "Monday through Friday", Span{14, 21}},
+		}},
+		{locale.EN_US, "0 0 12 L JAN,JUL ? 2026", []FieldExplanation{
+			{FieldSeconds, "0", "second", "0-59", "at 0 seconds past the minute", Span{0, 1}},
+			{FieldMinutes, "0", "minute", "0-59", "at 0 minutes past the hour", Span{2, 3}},
+			{FieldHours, "12", "hour", "0-23", "at 12:00 PM", Span{4, 6}},
+			{FieldDayOfMonth, "L", "day of month", "1-31", "on the last day of the month", Span{7, 8}},
+			{FieldMonth, "JAN,JUL", "month", "1-12, JAN-DEC", "only in January and July", Span{9, 16}},
+			{FieldDayOfWeek, "?", "day of week", "0-6, SUN-SAT", "on any day of the week", Span{17, 18}},
+			{FieldYear, "2026", "year", "1970-9999", "only in 2026", Span{19, 23}},
+		}},
+		{locale.DE_DE, "0 0 * * 3", []FieldExplanation{
+			{FieldMinutes, "0", "Minute", "0-59", "bei 0 Minuten nach der Stunde", Span{0, 1}},
+			{FieldHours, "0", "Stunde", "0-23", "um 12:00 AM", Span{2, 3}},
+			{FieldDayOfMonth, "*", "Tag des Monats", "1-31", "an jedem Tag des Monats", Span{4, 5}},
+			{FieldMonth, "*", "Monat", "1-12, JAN-DEC", "jeden Monat", Span{6, 7}},
+			{FieldDayOfWeek, "3", "Wochentag", "0-6, SUN-SAT", "nur mittwochs", Span{8, 9}},
+		}},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		rowList, err := NewDescriptor(val.cron, opts).Explain()
+		if err != nil {
+			t.Fatalf("%s: %v", val.cron, err)
+		}
+		if len(rowList) != len(val.expected) {
+			t.Errorf("%s: expected %d rows, got %v", val.cron, len(val.expected), rowList)
+			continue
+		}
+		for i, row := range rowList {
+			if row != val.expected[i] {
+				t.Errorf("%s: expected %+v, got %+v", val.cron, val.expected[i], row)
+			}
+		}
+	}
+}
+
+func TestDescriptor_Explain_error(t *testing.T) {
+	if _, err := NewDescriptor("* * *", NewDefaultOptions()).Explain(); err == nil {
+		t.Error("expected an error for a short expression")
+	}
+}
+
+func TestDescriptor_Explain_dayOfWeekStartIndexOne(t *testing.T) {
+	opts := NewDefaultOptions()
+	opts.DayOfWeekStartIndexZero = false
+	rowList, err := NewDescriptor("0 9 * * 2-6", opts).Explain()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := FieldExplanation{FieldDayOfWeek, "2-6", "day of week", "1-7, SUN-SAT", "Monday through Friday", Span{8, 11}}
+	if row := rowList[len(rowList)-1]; row != expected {
+		t.Errorf("expected %+v, got %+v", expected, row)
+	}
+	//the day of month token means the same whatever the day of week field says
+	if row := rowList[2]; row.Explanation != "on any day of the month" {
+		t.Errorf("expected any day of the month, got %+v", row)
+	}
+}
@@ -0,0 +1,13 @@
+package main
+
+const (
+	//FieldTypeEnum, in the order the fields appear in an expression
+	unuseField = iota
+	FieldSeconds
+	FieldMinutes
+	FieldHours
+	FieldDayOfMonth
+	FieldMonth
+	FieldDayOfWeek
+	FieldYear
+)
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// An expression can look valid and still never fire (i.e. "0 0 31 4 *", April has 30
+// days) or fire only rarely (i.e. "0 0 29 2 *", every leap year). The analysis walks
+// the days of the years the expression runs in, which without a year field are the 400
+// years after which the calendar repeats itself, days and weekdays alike. Times are
+// taken in UTC, a daylight saving change would shift the gaps by its offset. With the
+// FiringWarning option the description says so when the expression never or rarely fires.
+
+const (
+	// firingCycleStart is where the 400 years of an expression without a year field start
+	firingCycleStart = 2000
+	// secondsPerDay is a day of UTC, without daylight saving changes
+	secondsPerDay = 24 * 60 * 60
+)
+
+// FiringAnalysis is what the schedule of an expression allows over the years it runs in.
+// The gaps are between two consecutive runs, both are 0 when the expression fires at most
+// once. A gap too long for a time.Duration (about 292 years) is capped.
+type FiringAnalysis struct {
+	Fires  bool
+	Rarely bool
+	Count  int64
+	Years  int
+	MinGap time.Duration
+	MaxGap time.Duration
+}
+
+// AnalyzeFiring proves whether the expression ever fires and how far apart its runs are.
+// It rarely fires when it fires fewer times than there are years it runs in.
+func (self *descriptor) AnalyzeFiring() (*FiringAnalysis, error) {
+	entity, err := parse(self)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := newSchedule(entity, self.Options)
+	if err != nil {
+		return nil, err
+	}
+	return schedule.analyze(entity), nil
+}
+
+// analyze walks the days of the years of the expression, see AnalyzeFiring
+func (self *schedule) analyze(entity *cronEntity) *FiringAnalysis {
+	fromYear, toYear := firingCycleStart, firingCycleStart+maxSearchYears-1
+	//the gap from the last run of a cycle to the first one of the next cycle counts too
+	cyclic := true
+	if yearList, ok := yearsOf(entity); ok && self.yearSet != nil {
+		fromYear, toYear = yearList[0], yearList[len(yearList)-1]
+		cyclic = false
+	}
+	analysis := &FiringAnalysis{Years: toYear - fromYear + 1}
+
+	timeList := self.timesOfDay()
+	if len(timeList) == 0 {
+		return analysis
+	}
+	monthSet := make(map[int]bool)
+	for _, month := range self.monthList {
+		monthSet[month] = true
+	}
+
+	var minGap, maxGap int64 = math.MaxInt64, 0
+	fnGap := func(gap int64) {
+		if gap < minGap {
+			minGap = gap
+		}
+		if gap > maxGap {
+			maxGap = gap
+		}
+	}
+	for i := 1; i < len(timeList); i++ {
+		fnGap(timeList[i] - timeList[i-1])
+	}
+
+	end := time.Date(toYear+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := int64(-1)
+	for date, day := time.Date(fromYear, time.January, 1, 0, 0, 0, 0, time.UTC), int64(0); ; date, day = date.AddDate(0, 0, 1), day+1 {
+		afterEnd := !date.Before(end)
+		if afterEnd && (!cyclic || lastDay < 0) {
+			break
+		}
+		if self.yearSet != nil && !self.yearSet[date.Year()] || !monthSet[int(date.Month())] || !self.matchesDay(date) {
+			continue
+		}
+		if lastDay >= 0 {
+			fnGap((day-lastDay)*secondsPerDay - timeList[len(timeList)-1] + timeList[0])
+		}
+		if afterEnd {
+			break
+		}
+		lastDay = day
+		analysis.Count += int64(len(timeList))
+	}
+
+	analysis.Fires = analysis.Count > 0
+	analysis.Rarely = analysis.Fires && analysis.Count < int64(analysis.Years)
+	if analysis.Count > 1 {
+		analysis.MinGap, analysis.MaxGap = gapDuration(minGap), gapDuration(maxGap)
+	}
+	return analysis
+}
+
+// getFiringSegment warns with the FiringWarning option that the expression never fires
+// or fires less than once a year
+func (self *descriptor) getFiringSegment(entity *cronEntity) (Segment, bool) {
+	if !self.Options.FiringWarning {
+		return Segment{}, false
+	}
+	schedule, err := newSchedule(entity, self.Options)
+	if err != nil {
+		return Segment{}, false
+	}
+	analysis := schedule.analyze(entity)
+	if !analysis.Fires {
+		return newSegment(entity, SegmentWarning, self.Printer.Sprintf(" (never fires)")), true
+	} else if analysis.Rarely {
+		return newSegment(entity, SegmentWarning, self.Printer.Sprintf(" (fires less than once a year)")), true
+	}
+	return Segment{}, false
+}
+
+// timesOfDay lists in order the times of a day the expression fires, in seconds past midnight
+func (self *schedule) timesOfDay() []int64 {
+	timeList := make([]int64, 0)
+	for _, hour := range self.hourList {
+		for _, minute := range self.minuteList {
+			for _, second := range self.secondList {
+				timeList = append(timeList, int64(hour*3600+minute*60+second))
+			}
+		}
+	}
+	sort.Slice(timeList, func(i, j int) bool { return timeList[i] < timeList[j] })
+	return timeList
+}
+
+// gapDuration returns a gap in seconds as a duration, capped at the longest one
+func gapDuration(seconds int64) time.Duration {
+	if seconds > math.MaxInt64/int64(time.Second) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"testing"
+	"time"
+)
+
+func TestDescriptor_AnalyzeFiring(t *testing.T) {
+	const day = 24 * time.Hour
+	testList := []struct {
+		cron   string
+		fires  bool
+		rarely bool
+		minGap time.Duration
+		maxGap time.Duration
+	}{
+		{"0 0 31 4 *", false, false, 0, 0},
+		{"0 0 30 2 *", false, false, 0, 0},
+		{"0 0 29 2 * 2027", false, false, 0, 0},
+		{"0 0 ? * 6#5 2027", true, false, 63 * day, 119 * day},
+		{"0 0 29 2 *", true, true, (4*365 + 1) * day, (8*365 + 1) * day},
+		{"*/7 * * * *", true, false, 4 * time.Minute, 7 * time.Minute},
+		{"0 9 * * 1-5", true, false, day, 3 * day},
+		{"0 0 1 1 *", true, false, 365 * day, 366 * day},
+		{"0 0 1 1 * 2030", true, false, 0, 0},
+	}
+
+	for _, val := range testList {
+		analysis, err := NewDescriptor(val.cron, NewDefaultOptions()).AnalyzeFiring()
+		if err != nil {
+			t.Fatalf("%s: %v", val.cron, err)
+		}
+		if analysis.Fires != val.fires || analysis.Rarely != val.rarely {
+			t.Errorf("%s: expected fires %v and rarely %v, got %+v", val.cron, val.fires, val.rarely, analysis)
+		}
+		if analysis.MinGap != val.minGap || analysis.MaxGap != val.maxGap {
+			t.Errorf("%s: expected gaps of %v to %v, got %v to %v", val.cron, val.minGap, val.maxGap, analysis.MinGap, analysis.MaxGap)
+		}
+	}
+}
+
+func TestDescriptor_AnalyzeFiring_dialect(t *testing.T) {
+	//the 13th of the month and any Friday, rather than Friday the 13th
+	opts := NewDefaultOptions()
+	opts.Dialect = DialectVixie
+	analysis, err := NewDescriptor("0 0 13 * 5", opts).AnalyzeFiring()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if analysis.MaxGap != 7*24*time.Hour {
+		t.Errorf("expected a gap of a week at most, got %+v", analysis)
+	}
+}
+
+func TestDescriptor_GetDescription_firingWarning(t *testing.T) {
+	testList := []struct {
+		language int
+		cron     string
+		expected string
+	}{
+		{locale.EN_US, "0 0 30 2 *", "At midnight, on the 30th of the month, only in February (never fires)"},
+		{locale.EN_US, "0 0 29 2 *", "At midnight, on the 29th of the month, only in February (fires less than once a year)"},
+		{locale.EN_US, "0 0 1 * *", "Monthly"},
+		{locale.ZH_CN, "0 0 30 2 *", "在午夜，每月的30号，仅在二月（永远不会运行）"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		opts.FiringWarning = true
+		if description := NewDescriptor(val.cron, opts).GetDescription(); description != val.expected {
+			t.Errorf("%s: expected %q, got %q", val.cron, val.expected, description)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// Day and month names are put into the description as references between two
+// private use runes and only spelled out once the message they end up in is
+// known, because that message decides the grammatical form of the name
+// (i.e. "nur montags", "по понедельникам"). Spoken times of day are put in the same
+// way (see speech.go).
+var nameReferenceRegexp = regexp.MustCompile("\uE000([dmt])([\\d.]+)\uE001")
+
+func (self *descriptor) dayReference(dayNumber int) string {
+	if dayNumber < 0 || dayNumber >= len(WeekDayName) {
+		return ""
+	}
+	return fmt.Sprintf("\uE000d%d\uE001", dayNumber)
+}
+
+func (self *descriptor) monthReference(monthNumber int) string {
+	if _, ok := MonthName[monthNumber]; !ok {
+		return ""
+	}
+	return fmt.Sprintf("\uE000m%d\uE001", monthNumber)
+}
+
+// sprintf formats a message whose arguments may contain day or month references.
+// Each argument takes the form the locale requires for it in that message, and a
+// variant of the message agreeing with the gender of the first day is used when
+// the locale has one.
+func (self *descriptor) sprintf(key string, a ...interface{}) string {
+	language := self.Options.Language
+	forms := locale.MessageForms(language, key)
+
+	for _, arg := range a {
+		s, _ := arg.(string)
+		if match := nameReferenceRegexp.FindStringSubmatch(s); match != nil && match[1] != "t" {
+			if match[1] == "d" {
+				dayNumber, _ := strconv.Atoi(match[2])
+				genderedKey := locale.GenderedKey(key, locale.DayGender(language, dayNumber))
+				if locale.HasMessage(language, genderedKey) {
+					key = genderedKey
+				}
+			}
+			break
+		}
+	}
+
+	for i, arg := range a {
+		if s, ok := arg.(string); ok {
+			form := locale.Nominative
+			if i < len(forms) {
+				form = forms[i]
+			}
+			a[i] = self.resolveNames(s, form)
+		}
+	}
+
+	return self.Printer.Sprintf(key, a...)
+}
+
+func (self *descriptor) resolveNames(s string, form locale.Form) string {
+	return nameReferenceRegexp.ReplaceAllStringFunc(s, func(reference string) string {
+		match := nameReferenceRegexp.FindStringSubmatch(reference)
+		if match[1] == "t" {
+			return self.resolveTime(match[2], form)
+		}
+		number, _ := strconv.Atoi(match[2])
+		if match[1] == "d" {
+			return self.dayName(number, form)
+		}
+		return self.monthName(number, form)
+	})
+}
+
+func (self *descriptor) dayName(dayNumber int, form locale.Form) string {
+	if self.isShortStyle() {
+		if name, ok := locale.DayAbbreviation(self.Options.Language, dayNumber); ok {
+			return name
+		}
+	}
+	if name, ok := locale.DayName(self.Options.Language, dayNumber, form); ok {
+		return name
+	}
+	return self.Printer.Sprintf(WeekDayName[dayNumber])
+}
+
+func (self *descriptor) monthName(monthNumber int, form locale.Form) string {
+	if self.isShortStyle() {
+		if name, ok := locale.MonthAbbreviation(self.Options.Language, monthNumber); ok {
+			return name
+		}
+	}
+	if name, ok := locale.MonthName(self.Options.Language, monthNumber, form); ok {
+		return name
+	}
+	return self.Printer.Sprintf(MonthName[monthNumber])
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Lint finds what is valid but suspicious in an expression, i.e. "*/7" minutes
+// leaves a gap of 4 minutes at the top of the hour, along with what does not
+// parse. Each diagnostic names its rule, the suggestions (see suggest.go) are
+// rules too and carry their fix. The rules in the SuppressedRules option are
+// left out.
+
+// Rules of lint, besides those of the suggestions
+const (
+	RuleSyntax        = "syntax"
+	RuleInvalidField  = "invalid-field"
+	RuleUnevenStep    = "uneven-step"
+	RuleNeverFires    = "never-fires"
+	RuleRarelyFires   = "rarely-fires"
+	RuleBothDayFields = "both-day-fields"
+	RuleQuartzDays    = "quartz-day-fields"
+	RuleLastDayStep   = "last-day-step"
+)
+
+// LintRule is a rule of lint with the severity of its diagnostics
+type LintRule struct {
+	ID          string
+	Severity    int
+	Description string
+}
+
+var (
+	lintRuleList = []LintRule{
+		{RuleSyntax, SeverityError, "The expression does not split into 5 to 7 fields"},
+		{RuleInvalidField, SeverityError, "A field does not parse"},
+		{RuleNeverFires, SeverityError, "The expression never fires"},
+		{RuleRarelyFires, SeverityWarning, "The expression fires less than once a year"},
+		{RuleUnevenStep, SeverityWarning, "A step does not divide its field evenly and leaves a shorter gap"},
+		{RuleBothDayFields, SeverityWarning, "Both day fields are restricted, cron dialects disagree on what that means"},
+		{RuleQuartzDays, SeverityError, "Both day fields are given in Quartz, which needs ? in one of them"},
+		{RuleLastDayStep, SeverityError, "L is combined with a step"},
+		{RuleWildcardSeconds, SeverityWarning, "Every second of the minutes given, where once was likely meant"},
+		{RuleWildcardMinutes, SeverityWarning, "Every minute of the hours given, where once was likely meant"},
+		{RuleSingleStep, SeverityWarning, "A step as long as its field matches a single value"},
+		{RuleHour24, SeverityError, "Midnight is written 24"},
+		{RuleWrappingRange, SeverityError, "A range wraps around the end of its field"},
+		{RuleDayNamesInMonth, SeverityError, "Day names are in the day of month field"},
+		{RuleQuartzInUnix, SeverityWarning, "A Quartz expression where a Unix one is expected"},
+	}
+
+	severityNameList = map[int]string{
+		SeverityNote:    "note",
+		SeverityWarning: "warning",
+		SeverityError:   "error",
+	}
+
+	//fields whose steps should divide them, with their size
+	stepFieldSizeList = map[int]int{
+		FieldSeconds: 60,
+		FieldMinutes: 60,
+		FieldHours:   24,
+		FieldMonth:   12,
+	}
+)
+
+// Diagnostic is a problem lint found in the expression. Fix is the corrected expression,
+// empty when the rule has none.
+type Diagnostic struct {
+	Rule     string
+	Severity int
+	Field    int
+	Span     Span
+	Message  string
+	Fix      string
+}
+
+// LintRules returns the rules of lint
+func LintRules() []LintRule {
+	return lintRuleList
+}
+
+// SeverityName returns the name of a severity, i.e. "warning"
+func SeverityName(severity int) string {
+	return severityNameList[severity]
+}
+
+func lintRuleSeverity(rule string) int {
+	for _, lintRule := range lintRuleList {
+		if lintRule.ID == rule {
+			return lintRule.Severity
+		}
+	}
+	return SeverityWarning
+}
+
+// Lint returns the diagnostics of the expression in the order of the fields
+func (self *descriptor) Lint() []Diagnostic {
+	diagnosticList := self.lintExpression()
+
+	suppressed := make(map[string]bool)
+	for _, rule := range self.Options.SuppressedRules {
+		suppressed[rule] = true
+	}
+	filteredList := make([]Diagnostic, 0)
+	for _, diagnostic := range diagnosticList {
+		if !suppressed[diagnostic.Rule] {
+			filteredList = append(filteredList, diagnostic)
+		}
+	}
+	sort.SliceStable(filteredList, func(i, j int) bool {
+		return filteredList[i].Span.Start < filteredList[j].Span.Start
+	})
+	return filteredList
+}
+
+func (self *descriptor) lintExpression() []Diagnostic {
+	//the day fields are checked on their own, the dialect is not a parse error here
+	opts := *self.Options
+	opts.Dialect = DialectAnd
+	linter := &descriptor{Expression: self.Expression, Printer: self.Printer, Options: &opts}
+	entity, errList, err := parsePartial(linter)
+	if err != nil {
+		wholeSpan := Span{Start: 0, End: len(self.Expression)}
+		return []Diagnostic{{Rule: RuleSyntax, Severity: lintRuleSeverity(RuleSyntax), Span: wholeSpan, Message: err.Error()}}
+	}
+
+	diagnosticList := make([]Diagnostic, 0)
+	//the fields a rule already finds fault with are not reported as invalid too
+	coveredFields := make(map[int]bool)
+
+	//the suggestions only need the expression to split into its fields
+	suggestionList, _ := self.Suggest()
+	for _, suggestion := range suggestionList {
+		diagnosticList = append(diagnosticList, Diagnostic{
+			Rule:     suggestion.Rule,
+			Severity: lintRuleSeverity(suggestion.Rule),
+			Field:    suggestion.Field,
+			Span:     suggestion.Span,
+			Message:  suggestion.Explanation,
+			Fix:      suggestion.Expression,
+		})
+		coveredFields[suggestion.Field] = true
+	}
+
+	for _, field := range []int{FieldDayOfMonth, FieldDayOfWeek} {
+		token := self.token(entity, field)
+		if strings.Contains(token, "L") && strings.Contains(token, "/") {
+			diagnosticList = append(diagnosticList, self.newDiagnostic(entity, RuleLastDayStep, field,
+				self.Printer.Sprintf("L cannot be combined with a step")))
+			coveredFields[field] = true
+		}
+	}
+	for _, err := range errList {
+		fieldErr := err.(*FieldError)
+		if coveredFields[fieldErr.Field] {
+			continue
+		}
+		name := self.Printer.Sprintf(fieldLabelList[fieldErr.Field])
+		message := self.Printer.Sprintf("The %s is missing", name)
+		if fieldErr.Token != "" {
+			message = self.Printer.Sprintf("The %s '%s' is not valid", name, self.isolate(fieldErr.Token))
+		}
+		diagnosticList = append(diagnosticList, self.newDiagnostic(entity, RuleInvalidField, fieldErr.Field, message))
+	}
+	if len(errList) > 0 {
+		//the schedule of an expression that does not parse means nothing
+		return diagnosticList
+	}
+
+	for field := FieldSeconds; field <= FieldMonth; field++ {
+		if diagnostic, ok := self.lintUnevenStep(entity, field); ok && !coveredFields[field] {
+			diagnosticList = append(diagnosticList, diagnostic)
+		}
+	}
+	if checkDialect(entity, self.Options) != nil {
+		diagnosticList = append(diagnosticList, self.newDiagnostic(entity, RuleQuartzDays, FieldDayOfWeek,
+			self.Printer.Sprintf("Quartz does not allow both day fields, use ? in one of them")))
+	} else if dayFieldRestricted(entity.DayOfMonth) && dayFieldRestricted(entity.DayOfWeek) {
+		diagnosticList = append(diagnosticList, self.newDiagnostic(entity, RuleBothDayFields, FieldDayOfWeek,
+			self.Printer.Sprintf("Both day fields are restricted, Unix cron runs on the days matching either of them")))
+	}
+	if diagnostic, ok := self.lintFiring(entity); ok {
+		diagnosticList = append(diagnosticList, diagnostic)
+	}
+	return diagnosticList
+}
+
+func (self *descriptor) newDiagnostic(entity *cronEntity, rule string, field int, message string) Diagnostic {
+	return Diagnostic{
+		Rule:     rule,
+		Severity: lintRuleSeverity(rule),
+		Field:    field,
+		Span:     entity.Spans[field],
+		Message:  message,
+	}
+}
+
+// lintUnevenStep finds a step over the whole field that does not divide it, the gap
+// from the last value to the first is shorter than the others (i.e. */7 minutes
+// runs at 56 and then at 0)
+func (self *descriptor) lintUnevenStep(entity *cronEntity, field int) (Diagnostic, bool) {
+	size, ok := stepFieldSizeList[field]
+	if !ok {
+		return Diagnostic{}, false
+	}
+	for _, item := range strings.Split(entity.field(field), ",") {
+		if !strings.HasPrefix(item, "*/") {
+			continue
+		}
+		step, err := strconv.Atoi(strings.TrimPrefix(item, "*/"))
+		if err != nil || step >= size || size%step == 0 {
+			continue
+		}
+		gap := size - (size-1)/step*step
+		message := self.Printer.Sprintf("The step %s does not divide %s evenly, one gap is only %s",
+			self.isolate(item), strconv.Itoa(size), strconv.Itoa(gap))
+		return self.newDiagnostic(entity, RuleUnevenStep, field, message), true
+	}
+	return Diagnostic{}, false
+}
+
+// lintFiring finds an expression that never fires, or fires less than once a year (see
+// AnalyzeFiring)
+func (self *descriptor) lintFiring(entity *cronEntity) (Diagnostic, bool) {
+	schedule, err := newSchedule(entity, self.Options)
+	if err != nil {
+		return Diagnostic{}, false
+	}
+	wholeSpan := Span{Start: 0, End: len(self.Expression)}
+	analysis := schedule.analyze(entity)
+	if !analysis.Fires {
+		return Diagnostic{Rule: RuleNeverFires, Severity: lintRuleSeverity(RuleNeverFires), Span: wholeSpan,
+			Message: self.Printer.Sprintf("The expression never fires")}, true
+	}
+	if analysis.Rarely {
+		return Diagnostic{Rule: RuleRarelyFires, Severity: lintRuleSeverity(RuleRarelyFires), Span: wholeSpan,
+			Message: self.Printer.Sprintf("The expression fires less than once a year")}, true
+	}
+	return Diagnostic{}, false
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"bufio"
+	"cron-descriptor/locale"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// The lint command checks expressions for CI, one given as its arguments or one per
+// line of a file, where blank lines and comments (#) are skipped:
+//
+//	cron-descriptor lint -format sarif -file crontab.txt
+//
+// A comment after an expression suppresses rules for it alone, i.e.
+// "0 0 29 2 * # lint-ignore: rarely-fires". The exit code is 1 when an error is found.
+
+const lintIgnorePrefix = "lint-ignore:"
+
+var (
+	lintFormatList = map[string]func(w io.Writer, targetList []lintTarget){
+		"text":  writeLintText,
+		"json":  writeLintJSON,
+		"sarif": writeLintSARIF,
+	}
+
+	dialectNameList = map[string]int{
+		"and":    DialectAnd,
+		"vixie":  DialectVixie,
+		"quartz": DialectQuartz,
+	}
+)
+
+// lintTarget is an expression to lint and where it was found, Line is 0 and Column
+// the start of the expression when it was given as arguments
+type lintTarget struct {
+	File        string
+	Line        int
+	Column      int
+	Expression  string
+	Suppressed  []string
+	Diagnostics []Diagnostic
+}
+
+// runLint runs the lint command and returns its exit code
+func runLint(args []string, stdout, stderr io.Writer) int {
+	flagSet := flag.NewFlagSet("cron-descriptor lint", flag.ContinueOnError)
+	flagSet.SetOutput(stderr)
+	format := flagSet.String("format", "text", "output format: text, json or sarif")
+	suppress := flagSet.String("suppress", "", "comma separated rules to leave out, i.e. uneven-step,rarely-fires")
+	dialectName := flagSet.String("dialect", "and", "cron dialect: and, vixie or quartz")
+	localeName := flagSet.String("locale", "en_US", "locale of the messages, i.e. de_DE")
+	fileName := flagSet.String("file", "", "file of expressions, one per line")
+	if err := flagSet.Parse(args); err != nil {
+		return 2
+	}
+
+	fnWrite, ok := lintFormatList[*format]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown format %q\n", *format)
+		return 2
+	}
+	dialect, ok := dialectNameList[*dialectName]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown dialect %q\n", *dialectName)
+		return 2
+	}
+	localeType, ok := locale.Lookup(*localeName)
+	if !ok {
+		fmt.Fprintf(stderr, "unknown locale %q\n", *localeName)
+		return 2
+	}
+	suppressedList := splitRuleList(*suppress)
+	for _, rule := range suppressedList {
+		if !isLintRule(rule) {
+			fmt.Fprintf(stderr, "unknown rule %q\n", rule)
+			return 2
+		}
+	}
+
+	var targetList []lintTarget
+	if *fileName != "" {
+		file, err := os.Open(*fileName)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+		targetList, err = readLintTargets(*fileName, file)
+		file.Close()
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+	} else if expression := strings.Join(flagSet.Args(), " "); expression != "" {
+		targetList = []lintTarget{{Expression: expression}}
+	} else {
+		fmt.Fprintln(stderr, "usage: cron-descriptor lint [flags] expression")
+		flagSet.PrintDefaults()
+		return 2
+	}
+
+	code := 0
+	for i := range targetList {
+		opts := NewDefaultOptions()
+		opts.Language = localeType
+		opts.Dialect = dialect
+		opts.SuppressedRules = append(append([]string{}, suppressedList...), targetList[i].Suppressed...)
+		targetList[i].Diagnostics = NewDescriptor(targetList[i].Expression, opts).Lint()
+		for _, diagnostic := range targetList[i].Diagnostics {
+			if diagnostic.Severity == SeverityError {
+				code = 1
+			}
+		}
+	}
+	fnWrite(stdout, targetList)
+	return code
+}
+
+// readLintTargets reads the expressions of a file, one per line
+func readLintTargets(fileName string, r io.Reader) ([]lintTarget, error) {
+	targetList := make([]lintTarget, 0)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		comment := ""
+		if i := lintCommentIndex(text); i >= 0 {
+			text, comment = text[:i], strings.TrimSpace(text[i+1:])
+		}
+		expression := strings.TrimSpace(text)
+		if expression == "" {
+			continue
+		}
+		target := lintTarget{
+			File:       fileName,
+			Line:       line,
+			Column:     strings.Index(text, expression),
+			Expression: expression,
+		}
+		if strings.HasPrefix(comment, lintIgnorePrefix) {
+			target.Suppressed = splitRuleList(strings.TrimPrefix(comment, lintIgnorePrefix))
+		}
+		targetList = append(targetList, target)
+	}
+	return targetList, scanner.Err()
+}
+
+// lintCommentIndex returns where the comment of a line starts, -1 without one. A comment
+// starts with # at the start of the line or after a blank, the # of an nth day of the week
+// (i.e. 5#3) follows a digit.
+func lintCommentIndex(text string) int {
+	for i, r := range text {
+		if r == '#' && (i == 0 || text[i-1] == ' ' || text[i-1] == '\t') {
+			return i
+		}
+	}
+	return -1
+}
+
+// splitRuleList splits a comma separated list of rules
+func splitRuleList(text string) []string {
+	ruleList := make([]string, 0)
+	for _, rule := range strings.Split(text, ",") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			ruleList = append(ruleList, rule)
+		}
+	}
+	return ruleList
+}
+
+func isLintRule(rule string) bool {
+	for _, lintRule := range LintRules() {
+		if lintRule.ID == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// writeLintText writes a line for each diagnostic, prefixed with its position in the file
+// when there is one, and its fix below
+func writeLintText(w io.Writer, targetList []lintTarget) {
+	for _, target := range targetList {
+		for _, diagnostic := range target.Diagnostics {
+			if target.File != "" {
+				fmt.Fprintf(w, "%s:%d:%d: ", target.File, target.Line, target.Column+diagnostic.Span.Start+1)
+			}
+			fmt.Fprintf(w, "%s: %s [%s]\n", SeverityName(diagnostic.Severity), diagnostic.Message, diagnostic.Rule)
+			if diagnostic.Fix != "" {
+				fmt.Fprintf(w, "\tfix: %s\n", diagnostic.Fix)
+			}
+		}
+	}
+}
+
+type jsonLintTarget struct {
+	File        string           `json:"file,omitempty"`
+	Line        int              `json:"line,omitempty"`
+	Expression  string           `json:"expression"`
+	Diagnostics []jsonDiagnostic `json:"diagnostics"`
+}
+
+type jsonDiagnostic struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Field    string `json:"field,omitempty"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+	Message  string `json:"message"`
+	Fix      string `json:"fix,omitempty"`
+}
+
+// writeLintJSON writes the expressions with their diagnostics as a JSON array
+func writeLintJSON(w io.Writer, targetList []lintTarget) {
+	jsonList := make([]jsonLintTarget, 0)
+	for _, target := range targetList {
+		jsonTarget := jsonLintTarget{
+			File:        target.File,
+			Line:        target.Line,
+			Expression:  target.Expression,
+			Diagnostics: make([]jsonDiagnostic, 0),
+		}
+		for _, diagnostic := range target.Diagnostics {
+			jsonTarget.Diagnostics = append(jsonTarget.Diagnostics, jsonDiagnostic{
+				Rule:     diagnostic.Rule,
+				Severity: SeverityName(diagnostic.Severity),
+				Field:    FieldName(diagnostic.Field),
+				Start:    diagnostic.Span.Start,
+				End:      diagnostic.Span.End,
+				Message:  diagnostic.Message,
+				Fix:      diagnostic.Fix,
+			})
+		}
+		jsonList = append(jsonList, jsonTarget)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(jsonList)
+}
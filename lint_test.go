@@ -0,0 +1,108 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"strings"
+	"testing"
+)
+
+func TestDescriptor_Lint(t *testing.T) {
+	testList := []struct {
+		dialect  int
+		cron     string
+		rule     string
+		severity int
+		span     Span
+	}{
+		{DialectAnd, "*/7 * * * *", RuleUnevenStep, SeverityWarning, Span{0, 3}},
+		{DialectAnd, "0 */5 * * *", RuleUnevenStep, SeverityWarning, Span{2, 5}},
+		{DialectAnd, "0 0 30 2 *", RuleNeverFires, SeverityError, Span{0, 10}},
+		{DialectAnd, "0 0 29 2 *", RuleRarelyFires, SeverityWarning, Span{0, 10}},
+		{DialectAnd, "0 0 1 * MON", RuleBothDayFields, SeverityWarning, Span{8, 11}},
+		{DialectQuartz, "0 0 0 1 * MON", RuleQuartzDays, SeverityError, Span{10, 13}},
+		{DialectAnd, "0 0 L/2 * ?", RuleLastDayStep, SeverityError, Span{4, 7}},
+		{DialectAnd, "0 0 * * MOX", RuleInvalidField, SeverityError, Span{8, 11}},
+		{DialectAnd, "0 24 * * *", RuleHour24, SeverityError, Span{2, 4}},
+		{DialectAnd, "1 2 3 4 5 6 7 8", RuleSyntax, SeverityError, Span{0, 15}},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Dialect = val.dialect
+		diagnosticList := NewDescriptor(val.cron, opts).Lint()
+		if len(diagnosticList) != 1 {
+			t.Errorf("%s: expected a single diagnostic, got %+v", val.cron, diagnosticList)
+			continue
+		}
+		diagnostic := diagnosticList[0]
+		if diagnostic.Rule != val.rule || diagnostic.Severity != val.severity || diagnostic.Span != val.span {
+			t.Errorf("%s: expected %s %s at %v, got %+v", val.cron, SeverityName(val.severity), val.rule, val.span, diagnostic)
+		}
+	}
+}
+
+func TestDescriptor_Lint_clean(t *testing.T) {
+	for _, cron := range []string{"*/15 * * * *", "0 9 * * 1-5", "0 0 1 1 *", "0 0 12 L * ?", "0 0 1 1 * 2030"} {
+		if diagnosticList := NewDescriptor(cron, NewDefaultOptions()).Lint(); len(diagnosticList) != 0 {
+			t.Errorf("%s: expected no diagnostic, got %+v", cron, diagnosticList)
+		}
+	}
+}
+
+func TestDescriptor_Lint_order(t *testing.T) {
+	diagnosticList := NewDescriptor("0 0", NewDefaultOptions()).Lint()
+	expected := []string{"The day of month is missing", "The month is missing", "The day of week is missing"}
+	if len(diagnosticList) != len(expected) {
+		t.Fatalf("expected %d diagnostics, got %+v", len(expected), diagnosticList)
+	}
+	for i, diagnostic := range diagnosticList {
+		if diagnostic.Rule != RuleInvalidField || diagnostic.Message != expected[i] {
+			t.Errorf("expected %q, got %+v", expected[i], diagnostic)
+		}
+	}
+}
+
+func TestDescriptor_Lint_suppressed(t *testing.T) {
+	opts := NewDefaultOptions()
+	opts.SuppressedRules = []string{RuleRarelyFires, RuleUnevenStep}
+	if diagnosticList := NewDescriptor("*/7 0 29 2 *", opts).Lint(); len(diagnosticList) != 0 {
+		t.Errorf("expected the rules to be suppressed, got %+v", diagnosticList)
+	}
+}
+
+func TestDescriptor_Lint_message(t *testing.T) {
+	testList := []struct {
+		language int
+		expected string
+	}{
+		{locale.EN_US, "The step */7 does not divide 60 evenly, one gap is only 4"},
+		{locale.DE_DE, "Der Schritt */7 teilt 60 nicht gleichmäßig, eine Lücke ist nur 4 lang"},
+		{locale.JA_JP, "ステップ*/7は60を割り切れず、間隔の1つが4しかありません"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		diagnosticList := NewDescriptor("*/7 * * * *", opts).Lint()
+		if len(diagnosticList) != 1 || diagnosticList[0].Message != val.expected {
+			t.Errorf("%d: expected %q, got %+v", val.language, val.expected, diagnosticList)
+		}
+	}
+}
+
+func TestReadLintTargets(t *testing.T) {
+	text := "# nightly\n\n  0 24 * * *  # midnight\n0 0 29 2 * # lint-ignore: rarely-fires, uneven-step\n"
+	targetList, err := readLintTargets("crontab", strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targetList) != 2 {
+		t.Fatalf("expected 2 expressions, got %+v", targetList)
+	}
+	if target := targetList[0]; target.Line != 3 || target.Column != 2 || target.Expression != "0 24 * * *" || len(target.Suppressed) != 0 {
+		t.Errorf("unexpected target %+v", target)
+	}
+	if target := targetList[1]; target.Line != 4 || strings.Join(target.Suppressed, ",") != "rarely-fires,uneven-step" {
+		t.Errorf("unexpected target %+v", target)
+	}
+}
@@ -0,0 +1,91 @@
+package locale
+
+var (
+	//abbreviated names for short descriptions, locales without them use the full names
+	dayAbbreviationList = map[int][]string{
+		EN_US: enUSDayAbbreviations,
+		ZH_CN: zhCNDayAbbreviations,
+		DE_DE: deDEDayAbbreviations,
+		FR_FR: frFRDayAbbreviations,
+		ES_ES: esESDayAbbreviations,
+		IT_IT: itITDayAbbreviations,
+		PT_BR: ptBRDayAbbreviations,
+		NL_NL: nlNLDayAbbreviations,
+		RU_RU: ruRUDayAbbreviations,
+		PL_PL: plPLDayAbbreviations,
+		ZH_TW: zhTWDayAbbreviations,
+		JA_JP: jaJPDayAbbreviations,
+		KO_KR: koKRDayAbbreviations,
+		HE_IL: heILDayAbbreviations,
+	}
+
+	monthAbbreviationList = map[int][]string{
+		EN_US: enUSMonthAbbreviations,
+		ZH_CN: zhCNMonthAbbreviations,
+		DE_DE: deDEMonthAbbreviations,
+		FR_FR: frFRMonthAbbreviations,
+		ES_ES: esESMonthAbbreviations,
+		IT_IT: itITMonthAbbreviations,
+		PT_BR: ptBRMonthAbbreviations,
+		NL_NL: nlNLMonthAbbreviations,
+		RU_RU: ruRUMonthAbbreviations,
+		PL_PL: plPLMonthAbbreviations,
+		ZH_TW: zhTWMonthAbbreviations,
+		JA_JP: jaJPMonthAbbreviations,
+		KO_KR: koKRMonthAbbreviations,
+		HE_IL: heILMonthAbbreviations,
+	}
+
+	//dash between the bounds of a range, i.e. "Mon–Fri"
+	rangeSeparatorList = map[int]string{
+		ZH_CN: "至",
+		ZH_TW: "至",
+		JA_JP: "～",
+		KO_KR: "~",
+	}
+
+	//locales writing an ampersand for "and" in short text
+	ampersandList = map[int]bool{
+		EN_US: true,
+		DE_DE: true,
+		FR_FR: true,
+		ES_ES: true,
+		IT_IT: true,
+		PT_BR: true,
+		NL_NL: true,
+	}
+
+	enUSDayAbbreviations   = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+	enUSMonthAbbreviations = []string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+)
+
+// DayAbbreviation returns the abbreviated name of the day of week (0 = Sunday)
+func DayAbbreviation(localeType int, dayNumber int) (string, bool) {
+	nameList := dayAbbreviationList[localeType]
+	if dayNumber < 0 || dayNumber >= len(nameList) {
+		return "", false
+	}
+	return nameList[dayNumber], true
+}
+
+// MonthAbbreviation returns the abbreviated name of the month (1 = January)
+func MonthAbbreviation(localeType int, monthNumber int) (string, bool) {
+	nameList := monthAbbreviationList[localeType]
+	if monthNumber < 1 || monthNumber > len(nameList) {
+		return "", false
+	}
+	return nameList[monthNumber-1], true
+}
+
+// RangeSeparator returns the dash between the bounds of a range in short text
+func RangeSeparator(localeType int) string {
+	if separator, ok := rangeSeparatorList[localeType]; ok {
+		return separator
+	}
+	return "–"
+}
+
+// UsesAmpersand reports whether the locale writes "&" for "and" in short text
+func UsesAmpersand(localeType int) bool {
+	return ampersandList[localeType]
+}
@@ -0,0 +1,130 @@
+package locale
+
+var arSA = map[string]string{
+	"At %s":                                 "في الساعة %s",
+	"Every minute between %s and %s":        "كل دقيقة بين %s و %s",
+	"every second":                          "كل ثانية",
+	"every %s seconds":                      "كل %s ثانية",
+	"every %s seconds@few":                  "كل %s ثوانٍ",
+	"seconds %s through %s past the minute": "الثواني من %s إلى %s بعد الدقيقة",
+	"at %s seconds past the minute":         "عند الثانية %s بعد الدقيقة",
+	"every minute":                          "كل دقيقة",
+	"every %s minutes":                      "كل %s دقيقة",
+	"every %s minutes@few":                  "كل %s دقائق",
+	"minutes %s through %s past the hour":   "الدقائق من %s إلى %s بعد الساعة",
+	"at %s minutes past the hour":           "عند الدقيقة %s بعد الساعة",
+	"every hour":                            "كل ساعة",
+	"every %s hours":                        "كل %s ساعة",
+	"every %s hours@few":                    "كل %s ساعات",
+	"between %s and %s":                     "بين %s و %s",
+	"at %s":                                 "في الساعة %s",
+	", on the %s %s of the month":           "، في %[2]s رقم %[1]s من الشهر",
+	", on the last %s of the month":         "، في آخر %s من الشهر",
+	", only on %s":                          "، فقط يوم %s",
+	", every day":                           "، كل يوم",
+	", on any day of the month":             "، في أي يوم من الشهر",
+	", on any day of the week":              "، في أي يوم من الأسبوع",
+	", on any %s":                           "، في أي يوم %s",
+	"%s or %s":                              "%s أو %s",
+	"second (field)":                        "الثانية",
+	"minute (field)":                        "الدقيقة",
+	"hour (field)":                          "الساعة",
+	"day of month (field)":                  "يوم الشهر",
+	"month (field)":                         "الشهر",
+	"day of week (field)":                   "يوم الأسبوع",
+	"year (field)":                          "السنة",
+	"[invalid %s '%s']":                     "[قيمة غير صالحة في %s: '%s']",
+	"[missing %s]":                          "[حقل مفقود: %s]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "يعمل كل ثانية من الدقائق المحددة، استخدم 0 للتشغيل مرة واحدة في بداية الدقيقة",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "يعمل كل ثانية من الساعات المحددة، استخدم 0 للثواني والدقائق للتشغيل مرة واحدة في بداية الساعة",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "يعمل كل دقيقة من الساعات المحددة، استخدم 0 للتشغيل مرة واحدة في بداية الساعة",
+	"The step %s only matches %s":                                                        "الخطوة %s تطابق %s فقط",
+	"Hours go from 0 to 23, midnight is 0":                                               "الساعات من 0 إلى 23، ومنتصف الليل هو 0",
+	"Ranges do not wrap around, %s is written %s":                                        "النطاقات لا تلتف، يُكتب %s هكذا %s",
+	"Day names go in the day of week field":                                              "أسماء الأيام مكانها حقل يوم الأسبوع",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "هذا تعبير Quartz، ولا يحتوي cron في Unix على ثوانٍ أو سنوات أو ?",
+	"L cannot be combined with a step":                                                   "لا يمكن الجمع بين L وخطوة",
+	"The %s is missing":                                                                  "الحقل %s مفقود",
+	"The %s '%s' is not valid":                                                           "القيمة '%[2]s' في الحقل %[1]s غير صالحة",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "الخطوة %s لا تقسم %s بالتساوي، وإحدى الفترات %s فقط",
+	"Quartz does not allow both day fields, use ? in one of them":                        "لا يسمح Quartz بحقلي اليوم معًا، استخدم ? في أحدهما",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "حقلا اليوم كلاهما مقيدان، وcron في يونكس يعمل في الأيام المطابقة لأي منهما",
+	"The expression never fires":                                                         "التعبير لا يعمل أبدًا",
+	"The expression fires less than once a year":                                         "التعبير يعمل أقل من مرة في السنة",
+	", every %s days of the week":                                                        "، كل %s أيام من الأسبوع",
+	", %s through %s":                                                                    "، من %s إلى %s",
+	", every %s months":                                                                  "، كل %s أشهر",
+	", every %s months@many":                                                             "، كل %s شهرًا",
+	", every month":                                                                      "، كل شهر",
+	", only in %s":                                                                       "، فقط في %s",
+	", only in year %s":                                                                  "، فقط في عام %s",
+	", on %s":                                                                            "، يوم %s",
+	", in %s":                                                                            "، في %s",
+	", in year %s":                                                                       "، في عام %s",
+	", on the last day of the month":                                                     "، في آخر يوم من الشهر",
+	", on the last weekday of the month":                                                 "، في آخر يوم عمل من الشهر",
+	"first weekday":                                                                      "أول يوم عمل",
+	"weekday nearest the %s":                                                             "يوم العمل الأقرب إلى اليوم %s",
+	", on the %s of the month":                                                           "، في %s من الشهر",
+	", on the %s day of the month":                                                       "، في اليوم %s من الشهر",
+	", between the %s and %s of the month":                                               "، بين اليوم %s و %s من الشهر",
+	", every %s days":                                                                    "، كل %s أيام",
+	", every %s days@many":                                                               "، كل %s يومًا",
+	", every %s years":                                                                   "، كل %s سنوات",
+	", every %s years@many":                                                              "، كل %s سنة",
+	", every year":                                                                       "، كل سنة",
+	" (expired — last ran in %s)":                                                        " (منتهي — آخر تشغيل في %s)",
+	" (approximately)":                                                                   " (تقريبًا)",
+	" (never fires)":                                                                     " (لا يعمل أبدًا)",
+	" (fires less than once a year)":                                                     " (يعمل أقل من مرة في السنة)",
+	", starting in %s":                                                                   "، بدءًا من عام %s",
+	", starting in %s, until %s":                                                         "، بدءًا من عام %s حتى عام %s",
+	", this year only":                                                                   "، هذا العام فقط",
+	", next year only":                                                                   "، العام القادم فقط",
+	", e.g. %s":                                                                          "، مثلًا %s",
+	", starting %s":                                                                      "، بدءًا %s",
+	"every quarter hour":                                                                 "كل ربع ساعة",
+	"every half hour":                                                                    "كل نصف ساعة",
+	"every hour during business hours":                                                   "كل ساعة خلال ساعات العمل",
+	"At midnight":                                                                        "عند منتصف الليل",
+	"At noon":                                                                            "عند الظهر",
+	" on weekdays":                                                                       " في أيام العمل",
+	" on weekends":                                                                       " في عطلة نهاية الأسبوع",
+	"monthly":                                                                            "شهريًا",
+	"quarterly":                                                                          "كل ربع سنة",
+	"yearly":                                                                             "سنويًا",
+	", on the first day of every quarter":                                                "، في اليوم الأول من كل ربع سنة",
+	"every %s sec":                                                                       "كل %s ث",
+	"every %s min":                                                                       "كل %s د",
+	"every %s h":                                                                         "كل %s س",
+	"hourly":                                                                             "كل ساعة",
+	"last day":                                                                           "آخر يوم",
+	"last weekday":                                                                       "آخر يوم عمل",
+	"nearest weekday to the %s":                                                          "أقرب يوم عمل إلى يوم %s",
+	"every %s days":                                                                      "كل %s أيام",
+	"every %s days from the %s":                                                          "كل %s أيام من يوم %s",
+	"last %s":                                                                            "آخر %s",
+	" and ":                                                                              " و ",
+	", and ":                                                                             " و ",
+	", every minute":                                                                     "، كل دقيقة",
+	", every hour":                                                                       "، كل ساعة",
+	"Sunday":                                                                             "الأحد",
+	"Monday":                                                                             "الاثنين",
+	"Tuesday":                                                                            "الثلاثاء",
+	"Wednesday":                                                                          "الأربعاء",
+	"Thursday":                                                                           "الخميس",
+	"Friday":                                                                             "الجمعة",
+	"Saturday":                                                                           "السبت",
+	"January":                                                                            "يناير",
+	"February":                                                                           "فبراير",
+	"March":                                                                              "مارس",
+	"April":                                                                              "أبريل",
+	"May":                                                                                "مايو",
+	"June":                                                                               "يونيو",
+	"July":                                                                               "يوليو",
+	"August":                                                                             "أغسطس",
+	"September":                                                                          "سبتمبر",
+	"October":                                                                            "أكتوبر",
+	"November":                                                                           "نوفمبر",
+	"December":                                                                           "ديسمبر",
+}
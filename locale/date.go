@@ -0,0 +1,57 @@
+package locale
+
+// Date patterns of the example occurrences, with CLDR letters: EEE is the
+// abbreviated day name, MMM the abbreviated month name and MMMM the full one
+// in the genitive, M the month number, d the day and yyyy the year. The
+// patterns have no commas, the examples are listed with ListSeparator.
+var (
+	datePatternList = map[int]string{
+		EN_US: "EEE d MMM",
+		ZH_CN: "M月d日EEE",
+		DE_DE: "EEE d. MMM",
+		FR_FR: "EEE d MMM",
+		ES_ES: "EEE d MMM",
+		IT_IT: "EEE d MMM",
+		PT_BR: "EEE d MMM",
+		NL_NL: "EEE d MMM",
+		RU_RU: "EEE d MMMM",
+		PL_PL: "EEE d MMMM",
+		ZH_TW: "M月d日EEE",
+		JA_JP: "M月d日(EEE)",
+		KO_KR: "M월 d일 (EEE)",
+		AR_SA: "EEE d MMMM",
+		HE_IL: "EEE d בMMM",
+		FA_IR: "EEE d MMMM",
+	}
+
+	yearDatePatternList = map[int]string{
+		EN_US: "EEE d MMM yyyy",
+		ZH_CN: "yyyy年M月d日EEE",
+		DE_DE: "EEE d. MMM yyyy",
+		FR_FR: "EEE d MMM yyyy",
+		ES_ES: "EEE d MMM yyyy",
+		IT_IT: "EEE d MMM yyyy",
+		PT_BR: "EEE d MMM yyyy",
+		NL_NL: "EEE d MMM yyyy",
+		RU_RU: "EEE d MMMM yyyy",
+		PL_PL: "EEE d MMMM yyyy",
+		ZH_TW: "yyyy年M月d日EEE",
+		JA_JP: "yyyy年M月d日(EEE)",
+		KO_KR: "yyyy년 M월 d일 (EEE)",
+		AR_SA: "EEE d MMMM yyyy",
+		HE_IL: "EEE d בMMM yyyy",
+		FA_IR: "EEE d MMMM yyyy",
+	}
+)
+
+// DatePattern returns the pattern of a date of the locale, with the year or without it
+func DatePattern(localeType int, withYear bool) string {
+	patternList := datePatternList
+	if withYear {
+		patternList = yearDatePatternList
+	}
+	if pattern, ok := patternList[localeType]; ok {
+		return pattern
+	}
+	return patternList[EN_US]
+}
@@ -0,0 +1,143 @@
+package locale
+
+var deDE = map[string]string{
+	"At %s":                                 "Um %s",
+	"Every minute between %s and %s":        "Jede Minute zwischen %s und %s",
+	"every second":                          "jede Sekunde",
+	"every %s seconds":                      "alle %s Sekunden",
+	"seconds %s through %s past the minute": "Sekunden %s bis %s nach der Minute",
+	"at %s seconds past the minute":         "bei %s Sekunden nach der Minute",
+	"every minute":                          "jede Minute",
+	"every %s minutes":                      "alle %s Minuten",
+	"minutes %s through %s past the hour":   "Minuten %s bis %s nach der Stunde",
+	"at %s minutes past the hour":           "bei %s Minuten nach der Stunde",
+	"every hour":                            "jede Stunde",
+	"every %s hours":                        "alle %s Stunden",
+	"between %s and %s":                     "zwischen %s und %s",
+	"at %s":                                 "um %s",
+	", on the %s %s of the month":           ", am %s %s des Monats",
+	", on the last %s of the month":         ", am letzten %s des Monats",
+	", only on %s":                          ", nur %s",
+	", every day":                           ", jeden Tag",
+	", on any day of the month":             ", an jedem Tag des Monats",
+	", on any day of the week":              ", an jedem Tag der Woche",
+	", on any %s":                           ", an jedem %s",
+	"%s or %s":                              "%s oder %s",
+	"second (field)":                        "Sekunde",
+	"minute (field)":                        "Minute",
+	"hour (field)":                          "Stunde",
+	"day of month (field)":                  "Tag des Monats",
+	"month (field)":                         "Monat",
+	"day of week (field)":                   "Wochentag",
+	"year (field)":                          "Jahr",
+	"[invalid %s '%s']":                     "[%s ungültig: „%s“]",
+	"[missing %s]":                          "[%s fehlt]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "Läuft jede Sekunde der angegebenen Minuten, 0 lässt es einmal zu Beginn der Minute laufen",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "Läuft jede Sekunde der angegebenen Stunden, 0 für Sekunden und Minuten lässt es einmal zu Beginn der Stunde laufen",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "Läuft jede Minute der angegebenen Stunden, 0 lässt es einmal zu Beginn der Stunde laufen",
+	"The step %s only matches %s":                                                        "Der Schritt %s trifft nur %s",
+	"Hours go from 0 to 23, midnight is 0":                                               "Stunden gehen von 0 bis 23, Mitternacht ist 0",
+	"Ranges do not wrap around, %s is written %s":                                        "Bereiche laufen nicht über das Ende hinaus, %s wird %s geschrieben",
+	"Day names go in the day of week field":                                              "Wochentagsnamen gehören in das Feld Wochentag",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "Dies ist ein Quartz-Ausdruck, Unix-Cron kennt keine Sekunden, Jahre oder ?",
+	"L cannot be combined with a step":                                                   "L kann nicht mit einem Schritt kombiniert werden",
+	"The %s is missing":                                                                  "Das Feld %s fehlt",
+	"The %s '%s' is not valid":                                                           "Das Feld %s ist mit „%s“ ungültig",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "Der Schritt %s teilt %s nicht gleichmäßig, eine Lücke ist nur %s lang",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartz erlaubt nicht beide Tagesfelder, verwenden Sie ? in einem davon",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "Beide Tagesfelder sind eingeschränkt, Unix-Cron läuft an den Tagen, die einem von beiden entsprechen",
+	"The expression never fires":                                                         "Der Ausdruck wird nie ausgeführt",
+	"The expression fires less than once a year":                                         "Der Ausdruck wird seltener als einmal im Jahr ausgeführt",
+	", every %s days of the week":                                                        ", alle %s Wochentage",
+	", %s through %s":                                                                    ", %s bis %s",
+	", every %s months":                                                                  ", alle %s Monate",
+	", every month":                                                                      ", jeden Monat",
+	", only in %s":                                                                       ", nur im %s",
+	", only in year %s":                                                                  ", nur im Jahr %s",
+	", on %s":                                                                            ", %s",
+	", in %s":                                                                            ", im %s",
+	", in year %s":                                                                       ", im Jahr %s",
+	", on the last day of the month":                                                     ", am letzten Tag des Monats",
+	", on the last weekday of the month":                                                 ", am letzten Werktag des Monats",
+	"first weekday":                                                                      "ersten Werktag",
+	"weekday nearest the %s":                                                             "nächsten Werktag zum %s",
+	", on the %s of the month":                                                           ", am %s des Monats",
+	", on the %s day of the month":                                                       ", am %s des Monats",
+	", between the %s and %s of the month":                                               ", zwischen dem %s und %s des Monats",
+	", every %s days":                                                                    ", alle %s Tage",
+	", every %s years":                                                                   ", alle %s Jahre",
+	", every year":                                                                       ", jedes Jahr",
+	" (expired — last ran in %s)":                                                        " (abgelaufen — zuletzt %s ausgeführt)",
+	" (approximately)":                                                                   " (ungefähr)",
+	" (never fires)":                                                                     " (wird nie ausgeführt)",
+	" (fires less than once a year)":                                                     " (wird seltener als einmal im Jahr ausgeführt)",
+	", starting in %s":                                                                   ", ab %s",
+	", starting in %s, until %s":                                                         ", ab %s bis %s",
+	", this year only":                                                                   ", nur dieses Jahr",
+	", next year only":                                                                   ", nur nächstes Jahr",
+	", e.g. %s":                                                                          ", z. B. %s",
+	"%s o'clock":                                                                         "%s Uhr",
+	"%s:%s spoken":                                                                       "%s Uhr %s",
+	"%s:0%s spoken":                                                                      "%s Uhr %s",
+	"%s:%s:%s spoken":                                                                    "%s Uhr %s und %s Sekunden",
+	"%s a.m.":                                                                            "%s vormittags",
+	"%s p.m.":                                                                            "%s nachmittags",
+	", starting %s":                                                                      ", beginnend %s",
+	"every quarter hour":                                                                 "jede Viertelstunde",
+	"every half hour":                                                                    "jede halbe Stunde",
+	"every hour during business hours":                                                   "stündlich während der Geschäftszeiten",
+	"At midnight":                                                                        "Um Mitternacht",
+	"At noon":                                                                            "Mittags",
+	" on weekdays":                                                                       " an Werktagen",
+	" on weekends":                                                                       " am Wochenende",
+	"monthly":                                                                            "monatlich",
+	"quarterly":                                                                          "vierteljährlich",
+	"yearly":                                                                             "jährlich",
+	", on the first day of every quarter":                                                ", am ersten Tag jedes Quartals",
+	"every %s sec":                                                                       "alle %s Sek.",
+	"every %s min":                                                                       "alle %s Min.",
+	"every %s h":                                                                         "alle %s Std.",
+	"hourly":                                                                             "stündlich",
+	"last day":                                                                           "letzter Tag",
+	"last weekday":                                                                       "letzter Werktag",
+	"nearest weekday to the %s":                                                          "Werktag am nächsten zum %s",
+	"every %s days":                                                                      "alle %s Tage",
+	"every %s days from the %s":                                                          "alle %s Tage ab dem %s",
+	"last %s":                                                                            "letzter %s",
+	" and ":                                                                              " und ",
+	", and ":                                                                             " und ",
+	", every minute":                                                                     ", jede Minute",
+	", every hour":                                                                       ", jede Stunde",
+	"Sunday":                                                                             "Sonntag",
+	"Monday":                                                                             "Montag",
+	"Tuesday":                                                                            "Dienstag",
+	"Wednesday":                                                                          "Mittwoch",
+	"Thursday":                                                                           "Donnerstag",
+	"Friday":                                                                             "Freitag",
+	"Saturday":                                                                           "Samstag",
+	"January":                                                                            "Januar",
+	"February":                                                                           "Februar",
+	"March":                                                                              "März",
+	"April":                                                                              "April",
+	"May":                                                                                "Mai",
+	"June":                                                                               "Juni",
+	"July":                                                                               "Juli",
+	"August":                                                                             "August",
+	"September":                                                                          "September",
+	"October":                                                                            "Oktober",
+	"November":                                                                           "November",
+	"December":                                                                           "Dezember",
+}
+
+var deDEDayForms = map[Form][]string{
+	Plural: {"sonntags", "montags", "dienstags", "mittwochs", "donnerstags", "freitags", "samstags"},
+}
+
+var deDEMessageForms = map[string][]Form{
+	", only on %s": {Plural},
+	", on %s":      {Plural},
+}
+
+var deDEDayAbbreviations = []string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"}
+
+var deDEMonthAbbreviations = []string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"}
@@ -0,0 +1,148 @@
+package locale
+
+var esES = map[string]string{
+	"At %s":                                 "A las %s",
+	"Every minute between %s and %s":        "Cada minuto entre las %s y las %s",
+	"every second":                          "cada segundo",
+	"every %s seconds":                      "cada %s segundos",
+	"seconds %s through %s past the minute": "segundos %s al %s después del minuto",
+	"at %s seconds past the minute":         "a los %s segundos del minuto",
+	"every minute":                          "cada minuto",
+	"every %s minutes":                      "cada %s minutos",
+	"minutes %s through %s past the hour":   "minutos %s al %s después de la hora",
+	"at %s minutes past the hour":           "a los %s minutos de la hora",
+	"every hour":                            "cada hora",
+	"every %s hours":                        "cada %s horas",
+	"between %s and %s":                     "entre las %s y las %s",
+	"at %s":                                 "a las %s",
+	", on the %s %s of the month":           ", el %s %s del mes",
+	", on the last %s of the month":         ", el último %s del mes",
+	", only on %s":                          ", solo los %s",
+	", every day":                           ", todos los días",
+	", on any day of the month":             ", cualquier día del mes",
+	", on any day of the week":              ", cualquier día de la semana",
+	", on any %s":                           ", cualquier %s",
+	"%s or %s":                              "%s o %s",
+	"second (field)":                        "segundo",
+	"minute (field)":                        "minuto",
+	"hour (field)":                          "hora",
+	"day of month (field)":                  "día del mes",
+	"month (field)":                         "mes",
+	"day of week (field)":                   "día de la semana",
+	"year (field)":                          "año",
+	"[invalid %s '%s']":                     "[%s: «%s» no es válido]",
+	"[missing %s]":                          "[falta: %s]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "Se ejecuta cada segundo de los minutos indicados, usa 0 para ejecutarlo una vez al inicio del minuto",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "Se ejecuta cada segundo de las horas indicadas, usa 0 en los segundos y los minutos para ejecutarlo una vez al inicio de la hora",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "Se ejecuta cada minuto de las horas indicadas, usa 0 para ejecutarlo una vez al inicio de la hora",
+	"The step %s only matches %s":                                                        "El paso %s solo coincide con %s",
+	"Hours go from 0 to 23, midnight is 0":                                               "Las horas van de 0 a 23, la medianoche es 0",
+	"Ranges do not wrap around, %s is written %s":                                        "Los rangos no dan la vuelta, %s se escribe %s",
+	"Day names go in the day of week field":                                              "Los nombres de días van en el campo día de la semana",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "Es una expresión de Quartz, el cron de Unix no tiene segundos, años ni ?",
+	"L cannot be combined with a step":                                                   "L no se puede combinar con un paso",
+	"The %s is missing":                                                                  "Falta el campo %s",
+	"The %s '%s' is not valid":                                                           "El campo %s no es válido: «%s»",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "El paso %s no divide %s en partes iguales, un intervalo es de solo %s",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartz no permite ambos campos de día, use ? en uno de ellos",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "Ambos campos de día están restringidos, el cron de Unix se ejecuta los días que coinciden con cualquiera de ellos",
+	"The expression never fires":                                                         "La expresión nunca se ejecuta",
+	"The expression fires less than once a year":                                         "La expresión se ejecuta menos de una vez al año",
+	", every %s days of the week":                                                        ", cada %s días de la semana",
+	", %s through %s":                                                                    ", de %s a %s",
+	", every %s months":                                                                  ", cada %s meses",
+	", every month":                                                                      ", cada mes",
+	", only in %s":                                                                       ", solo en %s",
+	", only in year %s":                                                                  ", solo en %s",
+	", on %s":                                                                            ", los %s",
+	", in %s":                                                                            ", en %s",
+	", in year %s":                                                                       ", en %s",
+	", on the last day of the month":                                                     ", el último día del mes",
+	", on the last weekday of the month":                                                 ", el último día laborable del mes",
+	"first weekday":                                                                      "primer día laborable",
+	"weekday nearest the %s":                                                             "día laborable más próximo al día %s",
+	", on the %s of the month":                                                           ", el %s del mes",
+	", on the %s day of the month":                                                       ", el día %s del mes",
+	", on the %s days of the month":                                                      ", los días %s del mes",
+	", between the %s and %s of the month":                                               ", entre el día %s y el %s del mes",
+	", every %s days":                                                                    ", cada %s días",
+	", every %s years":                                                                   ", cada %s años",
+	", every year":                                                                       ", cada año",
+	" (expired — last ran in %s)":                                                        " (caducado — se ejecutó por última vez en %s)",
+	" (approximately)":                                                                   " (aproximadamente)",
+	" (never fires)":                                                                     " (nunca se ejecuta)",
+	" (fires less than once a year)":                                                     " (se ejecuta menos de una vez al año)",
+	", starting in %s":                                                                   ", a partir de %s",
+	", starting in %s, until %s":                                                         ", a partir de %s y hasta %s",
+	", this year only":                                                                   ", solo este año",
+	", next year only":                                                                   ", solo el próximo año",
+	", e.g. %s":                                                                          ", p. ej. %s",
+	"%s o'clock":                                                                         "%s en punto",
+	"%s:%s spoken":                                                                       "%s y %s",
+	"%s:0%s spoken":                                                                      "%s y %s",
+	"%s:%s:%s spoken":                                                                    "%s y %s y %s segundos",
+	"%s a.m.":                                                                            "%s de la mañana",
+	"%s p.m.":                                                                            "%s de la tarde",
+	", starting %s":                                                                      ", comenzando %s",
+	"every quarter hour":                                                                 "cada cuarto de hora",
+	"every half hour":                                                                    "cada media hora",
+	"every hour during business hours":                                                   "cada hora en horario laboral",
+	"At midnight":                                                                        "A medianoche",
+	"At noon":                                                                            "Al mediodía",
+	" on weekdays":                                                                       " entre semana",
+	" on weekends":                                                                       " los fines de semana",
+	"monthly":                                                                            "mensualmente",
+	"quarterly":                                                                          "trimestralmente",
+	"yearly":                                                                             "anualmente",
+	", on the first day of every quarter":                                                ", el primer día de cada trimestre",
+	"every %s sec":                                                                       "cada %s s",
+	"every %s min":                                                                       "cada %s min",
+	"every %s h":                                                                         "cada %s h",
+	"hourly":                                                                             "cada hora",
+	"last day":                                                                           "último día",
+	"last weekday":                                                                       "último día laborable",
+	"nearest weekday to the %s":                                                          "día laborable más cercano al %s",
+	"every %s days":                                                                      "cada %s días",
+	"every %s days from the %s":                                                          "cada %s días desde el %s",
+	"last %s":                                                                            "último %s",
+	" and ":                                                                              " y ",
+	", and ":                                                                             " y ",
+	", every minute":                                                                     ", cada minuto",
+	", every hour":                                                                       ", cada hora",
+	"Sunday":                                                                             "domingo",
+	"Monday":                                                                             "lunes",
+	"Tuesday":                                                                            "martes",
+	"Wednesday":                                                                          "miércoles",
+	"Thursday":                                                                           "jueves",
+	"Friday":                                                                             "viernes",
+	"Saturday":                                                                           "sábado",
+	"January":                                                                            "enero",
+	"February":                                                                           "febrero",
+	"March":                                                                              "marzo",
+	"April":                                                                              "abril",
+	"May":                                                                                "mayo",
+	"June":                                                                               "junio",
+	"July":                                                                               "julio",
+	"August":                                                                             "agosto",
+	"September":                                                                          "septiembre",
+	"October":                                                                            "octubre",
+	"November":                                                                           "noviembre",
+	"December":                                                                           "diciembre",
+}
+
+var esESDayForms = map[Form][]string{
+	Plural: {"domingos", "lunes", "martes", "miércoles", "jueves", "viernes", "sábados"},
+}
+
+var esESMessageForms = map[string][]Form{
+	", only on %s": {Plural},
+	", on %s":      {Plural},
+}
+
+var esESCountGenders = map[string]Gender{
+	"%s o'clock": Feminine,
+}
+
+var esESDayAbbreviations = []string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"}
+
+var esESMonthAbbreviations = []string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"}
@@ -0,0 +1,130 @@
+package locale
+
+var faIR = map[string]string{
+	"At %s":                                 "در ساعت %s",
+	"Every minute between %s and %s":        "هر دقیقه بین %s و %s",
+	"every second":                          "هر ثانیه",
+	"every %s seconds":                      "هر %s ثانیه",
+	"seconds %s through %s past the minute": "ثانیه‌های %s تا %s هر دقیقه",
+	"at %s seconds past the minute":         "در ثانیهٔ %s هر دقیقه",
+	"every minute":                          "هر دقیقه",
+	"every %s minutes":                      "هر %s دقیقه",
+	"minutes %s through %s past the hour":   "دقیقه‌های %s تا %s هر ساعت",
+	"at %s minutes past the hour":           "در دقیقهٔ %s هر ساعت",
+	"every hour":                            "هر ساعت",
+	"every %s hours":                        "هر %s ساعت",
+	"between %s and %s":                     "بین %s و %s",
+	"at %s":                                 "در ساعت %s",
+	", on the %s %s of the month":           "، در %s %s ماه",
+	", on the last %s of the month":         "، در آخرین %s ماه",
+	", only on %s":                          "، فقط در روز %s",
+	", every day":                           "، هر روز",
+	", on any day of the month":             "، در هر روز ماه",
+	", on any day of the week":              "، در هر روز هفته",
+	", on any %s":                           "، هر %s",
+	"%s or %s":                              "%s یا %s",
+	"second (field)":                        "ثانیه",
+	"minute (field)":                        "دقیقه",
+	"hour (field)":                          "ساعت",
+	"day of month (field)":                  "روز ماه",
+	"month (field)":                         "ماه",
+	"day of week (field)":                   "روز هفته",
+	"year (field)":                          "سال",
+	"[invalid %s '%s']":                     "[مقدار نامعتبر در %s: '%s']",
+	"[missing %s]":                          "[%s وارد نشده است]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "در هر ثانیه از دقیقه‌های داده‌شده اجرا می‌شود، برای یک بار اجرا در آغاز دقیقه از 0 استفاده کنید",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "در هر ثانیه از ساعت‌های داده‌شده اجرا می‌شود، برای یک بار اجرا در آغاز ساعت برای ثانیه و دقیقه از 0 استفاده کنید",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "در هر دقیقه از ساعت‌های داده‌شده اجرا می‌شود، برای یک بار اجرا در آغاز ساعت از 0 استفاده کنید",
+	"The step %s only matches %s":                                                        "گام %s فقط با %s مطابقت دارد",
+	"Hours go from 0 to 23, midnight is 0":                                               "ساعت‌ها از 0 تا 23 هستند و نیمه‌شب 0 است",
+	"Ranges do not wrap around, %s is written %s":                                        "بازه‌ها دور نمی‌زنند، %s به صورت %s نوشته می‌شود",
+	"Day names go in the day of week field":                                              "نام روزها در فیلد روز هفته می‌آید",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "این یک عبارت Quartz است، cron یونیکس ثانیه، سال یا ? ندارد",
+	"L cannot be combined with a step":                                                   "L را نمی‌توان با گام ترکیب کرد",
+	"The %s is missing":                                                                  "فیلد %s وارد نشده است",
+	"The %s '%s' is not valid":                                                           "مقدار '%[2]s' در فیلد %[1]s نامعتبر است",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "گام %s عدد %s را به‌طور مساوی تقسیم نمی‌کند و یکی از فاصله‌ها فقط %s است",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartz اجازهٔ هر دو فیلد روز را نمی‌دهد، در یکی از آن‌ها از ? استفاده کنید",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "هر دو فیلد روز محدود شده‌اند و cron یونیکس در روزهایی اجرا می‌شود که با هر کدام از آن‌ها مطابقت دارند",
+	"The expression never fires":                                                         "این عبارت هرگز اجرا نمی‌شود",
+	"The expression fires less than once a year":                                         "این عبارت کمتر از یک بار در سال اجرا می‌شود",
+	", every %s days of the week":                                                        "، هر %s روز هفته",
+	", %s through %s":                                                                    "، از %s تا %s",
+	", every %s months":                                                                  "، هر %s ماه",
+	", every month":                                                                      "، هر ماه",
+	", only in %s":                                                                       "، فقط در %s",
+	", only in year %s":                                                                  "، فقط در سال %s",
+	", on %s":                                                                            "، روز %s",
+	", in %s":                                                                            "، در %s",
+	", in year %s":                                                                       "، در سال %s",
+	", on the last day of the month":                                                     "، در آخرین روز ماه",
+	", on the last weekday of the month":                                                 "، در آخرین روز کاری ماه",
+	"first weekday":                                                                      "اولین روز کاری",
+	"weekday nearest the %s":                                                             "نزدیک‌ترین روز کاری به روز %s",
+	", on the %s of the month":                                                           "، در %s ماه",
+	", on the %s day of the month":                                                       "، در روز %s ماه",
+	", between the %s and %s of the month":                                               "، بین روز %s و %s ماه",
+	", every %s days":                                                                    "، هر %s روز",
+	", every %s years":                                                                   "، هر %s سال",
+	", every year":                                                                       "، هر سال",
+	" (expired — last ran in %s)":                                                        " (منقضی شده — آخرین اجرا در سال %s)",
+	" (approximately)":                                                                   " (تقریبی)",
+	" (never fires)":                                                                     " (هرگز اجرا نمی‌شود)",
+	" (fires less than once a year)":                                                     " (کمتر از یک بار در سال اجرا می‌شود)",
+	", starting in %s":                                                                   "، از سال %s",
+	", starting in %s, until %s":                                                         "، از سال %s تا سال %s",
+	", this year only":                                                                   "، فقط امسال",
+	", next year only":                                                                   "، فقط سال آینده",
+	", e.g. %s":                                                                          "، برای نمونه %s",
+	"%s o'clock":                                                                         "%s",
+	"%s:%s spoken":                                                                       "%s و %s دقیقه",
+	"%s:0%s spoken":                                                                      "%s و %s دقیقه",
+	"%s:%s:%s spoken":                                                                    "%s و %s دقیقه و %s ثانیه",
+	"%s a.m.":                                                                            "%s صبح",
+	"%s p.m.":                                                                            "%s بعدازظهر",
+	", starting %s":                                                                      "، با شروع %s",
+	"every quarter hour":                                                                 "هر ربع ساعت",
+	"every half hour":                                                                    "هر نیم ساعت",
+	"every hour during business hours":                                                   "هر ساعت در ساعات کاری",
+	"At midnight":                                                                        "در نیمه‌شب",
+	"At noon":                                                                            "در ظهر",
+	" on weekdays":                                                                       " در روزهای کاری",
+	" on weekends":                                                                       " در تعطیلات آخر هفته",
+	"monthly":                                                                            "ماهانه",
+	"quarterly":                                                                          "هر سه ماه",
+	"yearly":                                                                             "سالانه",
+	", on the first day of every quarter":                                                "، در روز اول هر فصل",
+	"every %s sec":                                                                       "هر %s ثانیه",
+	"every %s min":                                                                       "هر %s دقیقه",
+	"every %s h":                                                                         "هر %s ساعت",
+	"hourly":                                                                             "هر ساعت",
+	"last day":                                                                           "آخرین روز",
+	"last weekday":                                                                       "آخرین روز کاری",
+	"nearest weekday to the %s":                                                          "نزدیک‌ترین روز کاری به روز %s",
+	"every %s days":                                                                      "هر %s روز",
+	"every %s days from the %s":                                                          "هر %s روز از روز %s",
+	"last %s":                                                                            "آخرین %s",
+	" and ":                                                                              " و ",
+	", and ":                                                                             " و ",
+	", every minute":                                                                     "، هر دقیقه",
+	", every hour":                                                                       "، هر ساعت",
+	"Sunday":                                                                             "یکشنبه",
+	"Monday":                                                                             "دوشنبه",
+	"Tuesday":                                                                            "سه‌شنبه",
+	"Wednesday":                                                                          "چهارشنبه",
+	"Thursday":                                                                           "پنجشنبه",
+	"Friday":                                                                             "جمعه",
+	"Saturday":                                                                           "شنبه",
+	"January":                                                                            "ژانویه",
+	"February":                                                                           "فوریه",
+	"March":                                                                              "مارس",
+	"April":                                                                              "آوریل",
+	"May":                                                                                "مه",
+	"June":                                                                               "ژوئن",
+	"July":                                                                               "ژوئیه",
+	"August":                                                                             "اوت",
+	"September":                                                                          "سپتامبر",
+	"October":                                                                            "اکتبر",
+	"November":                                                                           "نوامبر",
+	"December":                                                                           "دسامبر",
+}
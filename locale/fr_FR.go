@@ -0,0 +1,151 @@
+package locale
+
+var frFR = map[string]string{
+	"At %s":                                 "À %s",
+	"Every minute between %s and %s":        "Toutes les minutes entre %s et %s",
+	"every second":                          "toutes les secondes",
+	"every %s seconds":                      "toutes les %s secondes",
+	"seconds %s through %s past the minute": "les secondes %s à %s après la minute",
+	"at %s seconds past the minute":         "à %s secondes après la minute",
+	"every minute":                          "toutes les minutes",
+	"every %s minutes":                      "toutes les %s minutes",
+	"minutes %s through %s past the hour":   "les minutes %s à %s après l'heure",
+	"at %s minutes past the hour":           "à %s minutes après l'heure",
+	"every hour":                            "toutes les heures",
+	"every %s hours":                        "toutes les %s heures",
+	"between %s and %s":                     "entre %s et %s",
+	"at %s":                                 "à %s",
+	", on the %s %s of the month":           ", le %s %s du mois",
+	", on the last %s of the month":         ", le dernier %s du mois",
+	", only on %s":                          ", uniquement les %s",
+	", every day":                           ", tous les jours",
+	", on any day of the month":             ", n'importe quel jour du mois",
+	", on any day of the week":              ", n'importe quel jour de la semaine",
+	", on any %s":                           ", n'importe quel %s",
+	"%s or %s":                              "%s ou %s",
+	"second (field)":                        "seconde",
+	"minute (field)":                        "minute",
+	"hour (field)":                          "heure",
+	"day of month (field)":                  "jour du mois",
+	"month (field)":                         "mois",
+	"day of week (field)":                   "jour de la semaine",
+	"year (field)":                          "année",
+	"[invalid %s '%s']":                     "[%s invalide : « %s »]",
+	"[missing %s]":                          "[champ manquant : %s]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "S'exécute à chaque seconde des minutes données, utilisez 0 pour une seule exécution au début de la minute",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "S'exécute à chaque seconde des heures données, utilisez 0 pour les secondes et les minutes pour une seule exécution au début de l'heure",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "S'exécute à chaque minute des heures données, utilisez 0 pour une seule exécution au début de l'heure",
+	"The step %s only matches %s":                                                        "Le pas %s ne correspond qu'à %s",
+	"Hours go from 0 to 23, midnight is 0":                                               "Les heures vont de 0 à 23, minuit est 0",
+	"Ranges do not wrap around, %s is written %s":                                        "Les plages ne bouclent pas, %s s'écrit %s",
+	"Day names go in the day of week field":                                              "Les noms de jours vont dans le champ jour de la semaine",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "Ceci est une expression Quartz, le cron Unix n'a ni secondes, ni années, ni ?",
+	"L cannot be combined with a step":                                                   "L ne peut pas être combiné avec un pas",
+	"The %s is missing":                                                                  "Le champ %s est manquant",
+	"The %s '%s' is not valid":                                                           "Le champ %s n'est pas valide : « %s »",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "Le pas %s ne divise pas %s de façon égale, un intervalle n'est que de %s",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartz n'autorise pas les deux champs de jour, utilisez ? dans l'un d'eux",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "Les deux champs de jour sont restreints, le cron Unix s'exécute les jours correspondant à l'un ou l'autre",
+	"The expression never fires":                                                         "L'expression ne s'exécute jamais",
+	"The expression fires less than once a year":                                         "L'expression s'exécute moins d'une fois par an",
+	", every %s days of the week":                                                        ", tous les %s jours de la semaine",
+	", %s through %s":                                                                    ", de %s à %s",
+	", every %s months":                                                                  ", tous les %s mois",
+	", every month":                                                                      ", chaque mois",
+	", only in %s":                                                                       ", uniquement en %s",
+	", only in year %s":                                                                  ", uniquement en %s",
+	", on %s":                                                                            ", les %s",
+	", in %s":                                                                            ", en %s",
+	", in year %s":                                                                       ", en %s",
+	", on the last day of the month":                                                     ", le dernier jour du mois",
+	", on the last weekday of the month":                                                 ", le dernier jour ouvrable du mois",
+	"first weekday":                                                                      "premier jour ouvrable",
+	"weekday nearest the %s":                                                             "jour ouvrable le plus proche du %s",
+	", on the %s of the month":                                                           ", le %s du mois",
+	", on the %s day of the month":                                                       ", le %s du mois",
+	", between the %s and %s of the month":                                               ", entre le %s et le %s du mois",
+	", every %s days":                                                                    ", tous les %s jours",
+	", every %s years":                                                                   ", tous les %s ans",
+	", every year":                                                                       ", chaque année",
+	" (expired — last ran in %s)":                                                        " (expiré — dernière exécution en %s)",
+	" (approximately)":                                                                   " (approximativement)",
+	" (never fires)":                                                                     " (ne s'exécute jamais)",
+	" (fires less than once a year)":                                                     " (s'exécute moins d'une fois par an)",
+	", starting in %s":                                                                   ", à partir de %s",
+	", starting in %s, until %s":                                                         ", à partir de %s et jusqu'en %s",
+	", this year only":                                                                   ", cette année uniquement",
+	", next year only":                                                                   ", l'année prochaine uniquement",
+	", e.g. %s":                                                                          ", par ex. %s",
+	"%s o'clock":                                                                         "%s heures",
+	"%s o'clock@one":                                                                     "%s heure",
+	"%s:%s spoken":                                                                       "%s heures %s",
+	"%s:%s spoken@one":                                                                   "%s heure %s",
+	"%s:0%s spoken":                                                                      "%s heures %s",
+	"%s:0%s spoken@one":                                                                  "%s heure %s",
+	"%s:%s:%s spoken":                                                                    "%s heures %s et %s secondes",
+	"%s:%s:%s spoken@one":                                                                "%s heure %s et %s secondes",
+	"%s a.m.":                                                                            "%s du matin",
+	"%s p.m.":                                                                            "%s de l'après-midi",
+	", starting %s":                                                                      ", en commençant %s",
+	"every quarter hour":                                                                 "tous les quarts d'heure",
+	"every half hour":                                                                    "toutes les demi-heures",
+	"every hour during business hours":                                                   "toutes les heures pendant les heures de bureau",
+	"At midnight":                                                                        "À minuit",
+	"At noon":                                                                            "À midi",
+	" on weekdays":                                                                       " en semaine",
+	" on weekends":                                                                       " le week-end",
+	"monthly":                                                                            "tous les mois",
+	"quarterly":                                                                          "tous les trimestres",
+	"yearly":                                                                             "tous les ans",
+	", on the first day of every quarter":                                                ", le premier jour de chaque trimestre",
+	"every %s sec":                                                                       "toutes les %s s",
+	"every %s min":                                                                       "toutes les %s min",
+	"every %s h":                                                                         "toutes les %s h",
+	"hourly":                                                                             "toutes les heures",
+	"last day":                                                                           "dernier jour",
+	"last weekday":                                                                       "dernier jour ouvré",
+	"nearest weekday to the %s":                                                          "jour ouvré le plus proche du %s",
+	"every %s days":                                                                      "tous les %s jours",
+	"every %s days from the %s":                                                          "tous les %s jours à partir du %s",
+	"last %s":                                                                            "dernier %s",
+	" and ":                                                                              " et ",
+	", and ":                                                                             " et ",
+	", every minute":                                                                     ", toutes les minutes",
+	", every hour":                                                                       ", toutes les heures",
+	"Sunday":                                                                             "dimanche",
+	"Monday":                                                                             "lundi",
+	"Tuesday":                                                                            "mardi",
+	"Wednesday":                                                                          "mercredi",
+	"Thursday":                                                                           "jeudi",
+	"Friday":                                                                             "vendredi",
+	"Saturday":                                                                           "samedi",
+	"January":                                                                            "janvier",
+	"February":                                                                           "février",
+	"March":                                                                              "mars",
+	"April":                                                                              "avril",
+	"May":                                                                                "mai",
+	"June":                                                                               "juin",
+	"July":                                                                               "juillet",
+	"August":                                                                             "août",
+	"September":                                                                          "septembre",
+	"October":                                                                            "octobre",
+	"November":                                                                           "novembre",
+	"December":                                                                           "décembre",
+}
+
+var frFRDayForms = map[Form][]string{
+	Plural: {"dimanches", "lundis", "mardis", "mercredis", "jeudis", "vendredis", "samedis"},
+}
+
+var frFRMessageForms = map[string][]Form{
+	", only on %s": {Plural},
+	", on %s":      {Plural},
+}
+
+var frFRCountGenders = map[string]Gender{
+	"%s o'clock": Feminine,
+}
+
+var frFRDayAbbreviations = []string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."}
+
+var frFRMonthAbbreviations = []string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."}
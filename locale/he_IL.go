@@ -0,0 +1,132 @@
+package locale
+
+var heIL = map[string]string{
+	"At %s":                                  "ב-%s",
+	"Every minute between %s and %s":         "כל דקה בין %s ל-%s",
+	"every second":                           "כל שנייה",
+	"every %s seconds":                       "כל %s שניות",
+	"seconds %s through %s past the minute":  "שניות %s עד %s אחרי הדקה",
+	"at %s seconds past the minute":          "ב-%s שניות אחרי הדקה",
+	"every minute":                           "כל דקה",
+	"every %s minutes":                       "כל %s דקות",
+	"minutes %s through %s past the hour":    "דקות %s עד %s אחרי השעה",
+	"at %s minutes past the hour":            "ב-%s דקות אחרי השעה",
+	"every hour":                             "כל שעה",
+	"every %s hours":                         "כל %s שעות",
+	"between %s and %s":                      "בין %s ל-%s",
+	"at %s":                                  "ב-%s",
+	", on the %s %s of the month":            ", ב%[2]s ה-%[1]s בחודש",
+	", on the last %s of the month":          ", ב%s האחרון בחודש",
+	", on the last %s of the month@feminine": ", ב%s האחרונה בחודש",
+	", only on %s":                           ", רק ב%s",
+	", every day":                            ", כל יום",
+	", on any day of the month":              ", בכל יום בחודש",
+	", on any day of the week":               ", בכל יום בשבוע",
+	", on any %s":                            ", בכל %s",
+	"%s or %s":                               "%s או %s",
+	"second (field)":                         "שנייה",
+	"minute (field)":                         "דקה",
+	"hour (field)":                           "שעה",
+	"day of month (field)":                   "יום בחודש",
+	"month (field)":                          "חודש",
+	"day of week (field)":                    "יום בשבוע",
+	"year (field)":                           "שנה",
+	"[invalid %s '%s']":                      "[ערך לא חוקי בשדה %s: '%s']",
+	"[missing %s]":                           "[חסר שדה: %s]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "רץ בכל שנייה של הדקות שצוינו, השתמשו ב-0 כדי להריץ פעם אחת בתחילת הדקה",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "רץ בכל שנייה של השעות שצוינו, השתמשו ב-0 לשניות ולדקות כדי להריץ פעם אחת בתחילת השעה",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "רץ בכל דקה של השעות שצוינו, השתמשו ב-0 כדי להריץ פעם אחת בתחילת השעה",
+	"The step %s only matches %s":                                                        "הצעד %s מתאים רק ל-%s",
+	"Hours go from 0 to 23, midnight is 0":                                               "השעות הן מ-0 עד 23, חצות היא 0",
+	"Ranges do not wrap around, %s is written %s":                                        "טווחים אינם מתגלגלים, את %s כותבים %s",
+	"Day names go in the day of week field":                                              "שמות ימים שייכים לשדה היום בשבוע",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "זהו ביטוי Quartz, ב-cron של Unix אין שניות, שנים או ?",
+	"L cannot be combined with a step":                                                   "לא ניתן לשלב L עם צעד",
+	"The %s is missing":                                                                  "השדה %s חסר",
+	"The %s '%s' is not valid":                                                           "הערך '%[2]s' בשדה %[1]s אינו חוקי",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "הצעד %s אינו מחלק את %s באופן שווה, אחד המרווחים הוא רק %s",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartz אינו מאפשר את שני שדות היום, השתמשו ב-? באחד מהם",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "שני שדות היום מוגבלים, cron של יוניקס רץ בימים שמתאימים לאחד מהם",
+	"The expression never fires":                                                         "הביטוי לעולם אינו רץ",
+	"The expression fires less than once a year":                                         "הביטוי רץ פחות מפעם בשנה",
+	", every %s days of the week":                                                        ", כל %s ימים בשבוע",
+	", %s through %s":                                                                    ", %s עד %s",
+	", every %s months":                                                                  ", כל %s חודשים",
+	", every month":                                                                      ", כל חודש",
+	", only in %s":                                                                       ", רק ב%s",
+	", only in year %s":                                                                  ", רק בשנת %s",
+	", on %s":                                                                            ", ב%s",
+	", in %s":                                                                            ", ב%s",
+	", in year %s":                                                                       ", בשנת %s",
+	", on the last day of the month":                                                     ", ביום האחרון בחודש",
+	", on the last weekday of the month":                                                 ", ביום העבודה האחרון בחודש",
+	"first weekday":                                                                      "יום העבודה הראשון",
+	"weekday nearest the %s":                                                             "יום העבודה הקרוב ביותר ל-%s",
+	", on the %s of the month":                                                           ", ב%s בחודש",
+	", on the %s day of the month":                                                       ", ב-%s בחודש",
+	", between the %s and %s of the month":                                               ", בין ה-%s ל-%s בחודש",
+	", every %s days":                                                                    ", כל %s ימים",
+	", every %s years":                                                                   ", כל %s שנים",
+	", every year":                                                                       ", כל שנה",
+	" (expired — last ran in %s)":                                                        " (פג תוקף — רץ לאחרונה ב-%s)",
+	" (approximately)":                                                                   " (בקירוב)",
+	" (never fires)":                                                                     " (לעולם אינו רץ)",
+	" (fires less than once a year)":                                                     " (רץ פחות מפעם בשנה)",
+	", starting in %s":                                                                   ", החל מ-%s",
+	", starting in %s, until %s":                                                         ", החל מ-%s ועד %s",
+	", this year only":                                                                   ", השנה בלבד",
+	", next year only":                                                                   ", בשנה הבאה בלבד",
+	", e.g. %s":                                                                          ", לדוגמה %s",
+	", starting %s":                                                                      ", החל %s",
+	"every quarter hour":                                                                 "כל רבע שעה",
+	"every half hour":                                                                    "כל חצי שעה",
+	"every hour during business hours":                                                   "כל שעה בשעות העבודה",
+	"At midnight":                                                                        "בחצות",
+	"At noon":                                                                            "בצהריים",
+	" on weekdays":                                                                       " בימי חול",
+	" on weekends":                                                                       " בסופי שבוע",
+	"monthly":                                                                            "מדי חודש",
+	"quarterly":                                                                          "מדי רבעון",
+	"yearly":                                                                             "מדי שנה",
+	", on the first day of every quarter":                                                ", ביום הראשון של כל רבעון",
+	"every %s sec":                                                                       "כל %s שנ׳",
+	"every %s min":                                                                       "כל %s דק׳",
+	"every %s h":                                                                         "כל %s שע׳",
+	"hourly":                                                                             "כל שעה",
+	"last day":                                                                           "היום האחרון",
+	"last weekday":                                                                       "יום העבודה האחרון",
+	"nearest weekday to the %s":                                                          "יום העבודה הקרוב ל-%s",
+	"every %s days":                                                                      "כל %s ימים",
+	"every %s days from the %s":                                                          "כל %s ימים החל מ-%s",
+	"last %s":                                                                            "%s האחרון",
+	"last %s@feminine":                                                                   "%s האחרונה",
+	" and ":                                                                              " ו-",
+	", and ":                                                                             " ו-",
+	", every minute":                                                                     ", כל דקה",
+	", every hour":                                                                       ", כל שעה",
+	"Sunday":                                                                             "יום ראשון",
+	"Monday":                                                                             "יום שני",
+	"Tuesday":                                                                            "יום שלישי",
+	"Wednesday":                                                                          "יום רביעי",
+	"Thursday":                                                                           "יום חמישי",
+	"Friday":                                                                             "יום שישי",
+	"Saturday":                                                                           "שבת",
+	"January":                                                                            "ינואר",
+	"February":                                                                           "פברואר",
+	"March":                                                                              "מרץ",
+	"April":                                                                              "אפריל",
+	"May":                                                                                "מאי",
+	"June":                                                                               "יוני",
+	"July":                                                                               "יולי",
+	"August":                                                                             "אוגוסט",
+	"September":                                                                          "ספטמבר",
+	"October":                                                                            "אוקטובר",
+	"November":                                                                           "נובמבר",
+	"December":                                                                           "דצמבר",
+}
+
+var heILDayGenders = []Gender{Masculine, Masculine, Masculine, Masculine, Masculine, Masculine, Feminine}
+
+var heILDayAbbreviations = []string{"יום א׳", "יום ב׳", "יום ג׳", "יום ד׳", "יום ה׳", "יום ו׳", "שבת"}
+
+var heILMonthAbbreviations = []string{"ינו׳", "פבר׳", "מרץ", "אפר׳", "מאי", "יוני", "יולי", "אוג׳", "ספט׳", "אוק׳", "נוב׳", "דצמ׳"}
@@ -0,0 +1,136 @@
+package locale
+
+// Form is the grammatical form a day or month name takes inside a message
+type Form int
+
+const (
+	Nominative Form = iota
+	Accusative
+	Genitive
+	Locative
+	//recurring use, i.e. "montags", "los lunes", "по понедельникам"
+	Plural
+)
+
+// Gender of a day name, messages agreeing with it are stored under GenderedKey
+type Gender int
+
+const (
+	Masculine Gender = iota
+	Feminine
+	Neuter
+)
+
+var (
+	genderSuffixList = map[Gender]string{
+		Feminine: "@feminine",
+		Neuter:   "@neuter",
+	}
+
+	formSuffixList = map[Form]string{
+		Accusative: "@accusative",
+		Genitive:   "@genitive",
+		Locative:   "@locative",
+		Plural:     "@plural",
+	}
+
+	//forms missing for a locale fall back to its nominative names,
+	//and locales without names here use the plain catalog translation
+	dayFormList = map[int]map[Form][]string{
+		DE_DE: deDEDayForms,
+		FR_FR: frFRDayForms,
+		ES_ES: esESDayForms,
+		PT_BR: ptBRDayForms,
+		RU_RU: ruRUDayForms,
+		PL_PL: plPLDayForms,
+	}
+
+	monthFormList = map[int]map[Form][]string{
+		RU_RU: ruRUMonthForms,
+		PL_PL: plPLMonthForms,
+	}
+
+	dayGenderList = map[int][]Gender{
+		IT_IT: itITDayGenders,
+		PT_BR: ptBRDayGenders,
+		RU_RU: ruRUDayGenders,
+		PL_PL: plPLDayGenders,
+		HE_IL: heILDayGenders,
+	}
+
+	//the form of each argument of a message, arguments not listed are nominative
+	messageFormList = map[int]map[string][]Form{
+		DE_DE: deDEMessageForms,
+		FR_FR: frFRMessageForms,
+		ES_ES: esESMessageForms,
+		PT_BR: ptBRMessageForms,
+		RU_RU: ruRUMessageForms,
+		PL_PL: plPLMessageForms,
+	}
+
+	//sound changes between the words of a description, i.e. "со среды" rather than "с среды"
+	euphonyList = map[int]func(text string) string{
+		RU_RU: ruRUEuphony,
+	}
+)
+
+// DayName returns the name of the day of week (0 = Sunday) in the given form
+func DayName(localeType int, dayNumber int, form Form) (string, bool) {
+	return inflectedName(dayFormList[localeType], dayNumber, form)
+}
+
+// MonthName returns the name of the month (1 = January) in the given form
+func MonthName(localeType int, monthNumber int, form Form) (string, bool) {
+	return inflectedName(monthFormList[localeType], monthNumber-1, form)
+}
+
+func inflectedName(formList map[Form][]string, index int, form Form) (string, bool) {
+	nameList, ok := formList[form]
+	if !ok {
+		nameList, ok = formList[Nominative]
+	}
+	if !ok || index < 0 || index >= len(nameList) {
+		return "", false
+	}
+	return nameList[index], true
+}
+
+// DayGender returns the grammatical gender of the day of week (0 = Sunday)
+func DayGender(localeType int, dayNumber int) Gender {
+	genderList := dayGenderList[localeType]
+	if dayNumber < 0 || dayNumber >= len(genderList) {
+		return Masculine
+	}
+	return genderList[dayNumber]
+}
+
+// MessageForms returns the forms of the arguments of the message
+func MessageForms(localeType int, key string) []Form {
+	return messageFormList[localeType][key]
+}
+
+// Euphony applies the sound changes of the locale between the words of a text
+func Euphony(localeType int, text string) string {
+	if fnEuphony, ok := euphonyList[localeType]; ok {
+		return fnEuphony(text)
+	}
+	return text
+}
+
+// GenderedKey returns the key of the message variant agreeing with the gender,
+// the masculine variant is the message itself
+func GenderedKey(key string, gender Gender) string {
+	return key + genderSuffixList[gender]
+}
+
+// FormKey returns the key of the message variant taking its count in the form,
+// the nominative variant is the message itself
+func FormKey(key string, form Form) string {
+	return key + formSuffixList[form]
+}
+
+// HasMessage reports whether the locale translates the message
+func HasMessage(localeType int, key string) bool {
+	_, ok := localeList[localeType][key]
+	return ok
+}
@@ -0,0 +1,24 @@
+package locale
+
+import "testing"
+
+func TestEuphony(t *testing.T) {
+	testList := []struct {
+		localeType int
+		text       string
+		expected   string
+	}{
+		{RU_RU, ", с среды по пятницу", ", со среды по пятницу"},
+		{RU_RU, "С вторника", "Со вторника"},
+		{RU_RU, ", в вторник месяца", ", во вторник месяца"},
+		{RU_RU, ", с семи часов утра", ", с семи часов утра"},
+		{RU_RU, ", в среду", ", в среду"},
+		{EN_US, "s sr", "s sr"},
+	}
+
+	for _, val := range testList {
+		if text := Euphony(val.localeType, val.text); text != val.expected {
+			t.Errorf("%d %q: expected %q, got %q", val.localeType, val.text, val.expected, text)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package locale
 
 import (
+	"strings"
+
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
 )
@@ -8,6 +10,20 @@ import (
 const (
 	EN_US = iota
 	ZH_CN
+	DE_DE
+	FR_FR
+	ES_ES
+	IT_IT
+	PT_BR
+	NL_NL
+	RU_RU
+	PL_PL
+	ZH_TW
+	JA_JP
+	KO_KR
+	AR_SA
+	HE_IL
+	FA_IR
 )
 
 var (
@@ -15,14 +31,149 @@ var (
 
 	languageTypeList = map[int]language.Tag{
 		EN_US: language.AmericanEnglish,
-		ZH_CN: language.Chinese,
+		ZH_CN: language.SimplifiedChinese,
+		DE_DE: language.German,
+		FR_FR: language.French,
+		ES_ES: language.Spanish,
+		IT_IT: language.Italian,
+		PT_BR: language.BrazilianPortuguese,
+		NL_NL: language.Dutch,
+		RU_RU: language.Russian,
+		PL_PL: language.Polish,
+		ZH_TW: language.TraditionalChinese,
+		JA_JP: language.Japanese,
+		KO_KR: language.Korean,
+		AR_SA: language.Arabic,
+		HE_IL: language.Hebrew,
+		FA_IR: language.Persian,
 	}
 
 	localeList = map[int]map[string]string{
 		ZH_CN: zhCN,
+		DE_DE: deDE,
+		FR_FR: frFR,
+		ES_ES: esES,
+		IT_IT: itIT,
+		PT_BR: ptBR,
+		NL_NL: nlNL,
+		RU_RU: ruRU,
+		PL_PL: plPL,
+		ZH_TW: zhTW,
+		JA_JP: jaJP,
+		KO_KR: koKR,
+		AR_SA: arSA,
+		HE_IL: heIL,
+		FA_IR: faIR,
+	}
+
+	//separator between the clauses of a description, messages starting a new
+	//clause begin with it
+	clauseSeparatorList = map[int]string{
+		ZH_CN: "，",
+		ZH_TW: "，",
+		JA_JP: "、",
+		AR_SA: "، ",
+		FA_IR: "، ",
+	}
+
+	//separator between the items of a list but the last two
+	listSeparatorList = map[int]string{
+		ZH_CN: "、",
+		ZH_TW: "、",
+		JA_JP: "、",
+		AR_SA: "، ",
+		FA_IR: "، ",
+	}
+
+	//scripts written from right to left
+	rightToLeftScriptList = map[string]bool{
+		"Arab": true,
+		"Hebr": true,
+		"Syrc": true,
+		"Thaa": true,
+	}
+
+	//days of the weekend, 0 is Sunday, the other days are working days
+	weekendList = map[int][]int{
+		AR_SA: {5, 6},
+		HE_IL: {5, 6},
+		FA_IR: {5},
+	}
+
+	//words kept in lower case by title casing unless they start the description
+	titleSmallWordList = map[int][]string{
+		EN_US: {"a", "an", "and", "as", "at", "but", "by", "for", "in", "nor", "of", "on", "or", "the", "to"},
+		DE_DE: {"am", "bis", "bei", "der", "des", "im", "nach", "um", "und", "zum", "zwischen"},
+		FR_FR: {"à", "au", "de", "des", "du", "en", "entre", "et", "l", "la", "le", "les"},
+		ES_ES: {"a", "al", "de", "del", "el", "en", "entre", "la", "las", "los", "y"},
+		IT_IT: {"a", "al", "da", "del", "di", "e", "il", "l", "la", "le", "nel", "tra"},
+		PT_BR: {"a", "à", "às", "aos", "da", "de", "do", "e", "em", "entre", "na", "no", "os"},
+		NL_NL: {"de", "en", "het", "in", "met", "na", "op", "tot", "van"},
 	}
 )
 
+// Tag returns the language tag of the locale, falling back to English
+func Tag(localeType int) language.Tag {
+	languageTag, ok := languageTypeList[localeType]
+	if !ok {
+		return defaultLanguageTag
+	}
+	return languageTag
+}
+
+// Lookup returns the locale of a name such as "de_DE", "pt-BR" or "ja", ok is false
+// when no locale is close enough
+func Lookup(name string) (int, bool) {
+	tag, err := language.Parse(strings.Replace(name, "_", "-", -1))
+	if err != nil {
+		return 0, false
+	}
+	tagList := make([]language.Tag, len(languageTypeList))
+	for localeType, languageTag := range languageTypeList {
+		tagList[localeType] = languageTag
+	}
+	_, index, confidence := language.NewMatcher(tagList).Match(tag)
+	if confidence < language.High {
+		return 0, false
+	}
+	return index, true
+}
+
+// ClauseSeparator returns the separator between the clauses of a description
+func ClauseSeparator(localeType int) string {
+	if separator, ok := clauseSeparatorList[localeType]; ok {
+		return separator
+	}
+	return ", "
+}
+
+// ListSeparator returns the separator between the items of a list
+func ListSeparator(localeType int) string {
+	if separator, ok := listSeparatorList[localeType]; ok {
+		return separator
+	}
+	return ", "
+}
+
+// IsRightToLeft reports whether the locale is written from right to left
+func IsRightToLeft(localeType int) bool {
+	script, _ := Tag(localeType).Script()
+	return rightToLeftScriptList[script.String()]
+}
+
+// TitleSmallWords returns the words the locale keeps in lower case in title casing
+func TitleSmallWords(localeType int) []string {
+	return titleSmallWordList[localeType]
+}
+
+// Weekend returns the days of the weekend of the locale, 0 is Sunday
+func Weekend(localeType int) []int {
+	if weekend, ok := weekendList[localeType]; ok {
+		return weekend
+	}
+	return []int{0, 6}
+}
+
 func NewPrinter(localeType int) *message.Printer {
 	languageTag, ok := languageTypeList[localeType]
 	if !ok {
@@ -0,0 +1,27 @@
+package locale
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestCatalogs checks that every catalog translates the messages of the German one,
+// which has no message variants of its own. Locales without number words have no
+// spoken times to translate.
+func TestCatalogs(t *testing.T) {
+	for localeType, catalog := range localeList {
+		_, hasWords := NumberWord(localeType, 1, Masculine)
+		for key := range deDE {
+			if strings.Contains(key, "@") || (!hasWords && isSpokenTimeKey(key)) {
+				continue
+			}
+			if _, ok := catalog[key]; !ok {
+				t.Errorf("%s: no translation of %q", Tag(localeType), key)
+			}
+		}
+	}
+}
+
+func isSpokenTimeKey(key string) bool {
+	return strings.HasSuffix(key, " spoken") || key == "%s o'clock" || key == "%s a.m." || key == "%s p.m."
+}
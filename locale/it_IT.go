@@ -0,0 +1,140 @@
+package locale
+
+var itIT = map[string]string{
+	"At %s":                                  "Alle %s",
+	"Every minute between %s and %s":         "Ogni minuto tra le %s e le %s",
+	"every second":                           "ogni secondo",
+	"every %s seconds":                       "ogni %s secondi",
+	"seconds %s through %s past the minute":  "secondi da %s a %s dopo il minuto",
+	"at %s seconds past the minute":          "al secondo %s dopo il minuto",
+	"every minute":                           "ogni minuto",
+	"every %s minutes":                       "ogni %s minuti",
+	"minutes %s through %s past the hour":    "minuti da %s a %s dopo l'ora",
+	"at %s minutes past the hour":            "al minuto %s dopo l'ora",
+	"every hour":                             "ogni ora",
+	"every %s hours":                         "ogni %s ore",
+	"between %s and %s":                      "tra le %s e le %s",
+	"at %s":                                  "alle %s",
+	", on the %s %s of the month":            ", il %s %s del mese",
+	", on the %s %s of the month@feminine":   ", la %s %s del mese",
+	", on the last %s of the month":          ", l'ultimo %s del mese",
+	", on the last %s of the month@feminine": ", l'ultima %s del mese",
+	", only on %s":                           ", solo di %s",
+	", every day":                            ", ogni giorno",
+	", on any day of the month":              ", in qualsiasi giorno del mese",
+	", on any day of the week":               ", in qualsiasi giorno della settimana",
+	", on any %s":                            ", qualsiasi %s",
+	"%s or %s":                               "%s o %s",
+	"second (field)":                         "secondo",
+	"minute (field)":                         "minuto",
+	"hour (field)":                           "ora",
+	"day of month (field)":                   "giorno del mese",
+	"month (field)":                          "mese",
+	"day of week (field)":                    "giorno della settimana",
+	"year (field)":                           "anno",
+	"[invalid %s '%s']":                      "[%s: «%s» non è valido]",
+	"[missing %s]":                           "[manca: %s]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "Viene eseguito ogni secondo dei minuti indicati, usa 0 per eseguirlo una volta all'inizio del minuto",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "Viene eseguito ogni secondo delle ore indicate, usa 0 per i secondi e i minuti per eseguirlo una volta all'inizio dell'ora",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "Viene eseguito ogni minuto delle ore indicate, usa 0 per eseguirlo una volta all'inizio dell'ora",
+	"The step %s only matches %s":                                                        "Il passo %s corrisponde solo a %s",
+	"Hours go from 0 to 23, midnight is 0":                                               "Le ore vanno da 0 a 23, la mezzanotte è 0",
+	"Ranges do not wrap around, %s is written %s":                                        "Gli intervalli non ricominciano da capo, %s si scrive %s",
+	"Day names go in the day of week field":                                              "I nomi dei giorni vanno nel campo giorno della settimana",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "Questa è un'espressione Quartz, il cron Unix non ha secondi, anni né ?",
+	"L cannot be combined with a step":                                                   "L non può essere combinato con un passo",
+	"The %s is missing":                                                                  "Manca il campo %s",
+	"The %s '%s' is not valid":                                                           "Il campo %s non è valido: «%s»",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "Il passo %s non divide %s in modo uniforme, un intervallo è di soli %s",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartz non consente entrambi i campi del giorno, usa ? in uno dei due",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "Entrambi i campi del giorno sono limitati, il cron di Unix viene eseguito nei giorni che corrispondono a uno dei due",
+	"The expression never fires":                                                         "L'espressione non viene mai eseguita",
+	"The expression fires less than once a year":                                         "L'espressione viene eseguita meno di una volta all'anno",
+	", every %s days of the week":                                                        ", ogni %s giorni della settimana",
+	", %s through %s":                                                                    ", da %s a %s",
+	", every %s months":                                                                  ", ogni %s mesi",
+	", every month":                                                                      ", ogni mese",
+	", only in %s":                                                                       ", solo a %s",
+	", only in year %s":                                                                  ", solo nel %s",
+	", on %s":                                                                            ", di %s",
+	", in %s":                                                                            ", a %s",
+	", in year %s":                                                                       ", nel %s",
+	", on the last day of the month":                                                     ", l'ultimo giorno del mese",
+	", on the last weekday of the month":                                                 ", l'ultimo giorno feriale del mese",
+	"first weekday":                                                                      "primo giorno feriale",
+	"weekday nearest the %s":                                                             "giorno feriale più vicino al giorno %s",
+	", on the %s of the month":                                                           ", il %s del mese",
+	", on the %s day of the month":                                                       ", il giorno %s del mese",
+	", on the %s days of the month":                                                      ", i giorni %s del mese",
+	", between the %s and %s of the month":                                               ", tra il giorno %s e il %s del mese",
+	", every %s days":                                                                    ", ogni %s giorni",
+	", every %s years":                                                                   ", ogni %s anni",
+	", every year":                                                                       ", ogni anno",
+	" (expired — last ran in %s)":                                                        " (scaduto — ultima esecuzione nel %s)",
+	" (approximately)":                                                                   " (approssimativamente)",
+	" (never fires)":                                                                     " (non viene mai eseguita)",
+	" (fires less than once a year)":                                                     " (viene eseguita meno di una volta all'anno)",
+	", starting in %s":                                                                   ", a partire dal %s",
+	", starting in %s, until %s":                                                         ", a partire dal %s fino al %s",
+	", this year only":                                                                   ", solo quest'anno",
+	", next year only":                                                                   ", solo l'anno prossimo",
+	", e.g. %s":                                                                          ", ad es. %s",
+	"%s o'clock":                                                                         "%s in punto",
+	"%s:%s spoken":                                                                       "%s e %s",
+	"%s:0%s spoken":                                                                      "%s e %s",
+	"%s:%s:%s spoken":                                                                    "%s e %s e %s secondi",
+	"%s a.m.":                                                                            "%s del mattino",
+	"%s p.m.":                                                                            "%s del pomeriggio",
+	", starting %s":                                                                      ", iniziando %s",
+	"every quarter hour":                                                                 "ogni quarto d'ora",
+	"every half hour":                                                                    "ogni mezz'ora",
+	"every hour during business hours":                                                   "ogni ora durante l'orario lavorativo",
+	"At midnight":                                                                        "A mezzanotte",
+	"At noon":                                                                            "A mezzogiorno",
+	" on weekdays":                                                                       " nei giorni feriali",
+	" on weekends":                                                                       " nel fine settimana",
+	"monthly":                                                                            "mensilmente",
+	"quarterly":                                                                          "trimestralmente",
+	"yearly":                                                                             "annualmente",
+	", on the first day of every quarter":                                                ", il primo giorno di ogni trimestre",
+	"every %s sec":                                                                       "ogni %s s",
+	"every %s min":                                                                       "ogni %s min",
+	"every %s h":                                                                         "ogni %s h",
+	"hourly":                                                                             "ogni ora",
+	"last day":                                                                           "ultimo giorno",
+	"last weekday":                                                                       "ultimo giorno feriale",
+	"nearest weekday to the %s":                                                          "giorno feriale più vicino al %s",
+	"every %s days":                                                                      "ogni %s giorni",
+	"every %s days from the %s":                                                          "ogni %s giorni dal %s",
+	"last %s":                                                                            "ultimo %s",
+	"last %s@feminine":                                                                   "ultima %s",
+	" and ":                                                                              " e ",
+	", and ":                                                                             " e ",
+	", every minute":                                                                     ", ogni minuto",
+	", every hour":                                                                       ", ogni ora",
+	"Sunday":                                                                             "domenica",
+	"Monday":                                                                             "lunedì",
+	"Tuesday":                                                                            "martedì",
+	"Wednesday":                                                                          "mercoledì",
+	"Thursday":                                                                           "giovedì",
+	"Friday":                                                                             "venerdì",
+	"Saturday":                                                                           "sabato",
+	"January":                                                                            "gennaio",
+	"February":                                                                           "febbraio",
+	"March":                                                                              "marzo",
+	"April":                                                                              "aprile",
+	"May":                                                                                "maggio",
+	"June":                                                                               "giugno",
+	"July":                                                                               "luglio",
+	"August":                                                                             "agosto",
+	"September":                                                                          "settembre",
+	"October":                                                                            "ottobre",
+	"November":                                                                           "novembre",
+	"December":                                                                           "dicembre",
+}
+
+var itITDayGenders = []Gender{Feminine, Masculine, Masculine, Masculine, Masculine, Masculine, Masculine}
+
+var itITDayAbbreviations = []string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"}
+
+var itITMonthAbbreviations = []string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"}
@@ -0,0 +1,135 @@
+package locale
+
+var jaJP = map[string]string{
+	"At %s":                                 "%sに",
+	"Every minute between %s and %s":        "%sから%sまで毎分",
+	"every second":                          "毎秒",
+	"every %s seconds":                      "%s秒ごと",
+	"seconds %s through %s past the minute": "毎分%s秒から%s秒まで",
+	"at %s seconds past the minute":         "毎分%s秒",
+	"every minute":                          "毎分",
+	"every %s minutes":                      "%s分ごと",
+	"minutes %s through %s past the hour":   "毎時%s分から%s分まで",
+	"at %s minutes past the hour":           "毎時%s分",
+	"every hour":                            "毎時",
+	"every %s hours":                        "%s時間ごと",
+	"between %s and %s":                     "%sから%sまで",
+	"at %s":                                 "%sに",
+	", on the %s %s of the month":           "、毎月%s%s",
+	", on the last %s of the month":         "、毎月最終%s",
+	", only on %s":                          "、%sのみ",
+	", every day":                           "、毎日",
+	", on any day of the month":             "、月の任意の日",
+	", on any day of the week":              "、任意の曜日",
+	", on any %s":                           "、毎週%s",
+	"%s or %s":                              "%sまたは%s",
+	"second (field)":                        "秒",
+	"minute (field)":                        "分",
+	"hour (field)":                          "時",
+	"day of month (field)":                  "日",
+	"month (field)":                         "月",
+	"day of week (field)":                   "曜日",
+	"year (field)":                          "年",
+	"[invalid %s '%s']":                     "[無効な%s「%s」]",
+	"[missing %s]":                          "[%sがありません]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "指定した分の毎秒に実行されます。分の始めに1回だけ実行するには0を使います",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "指定した時間の毎秒に実行されます。時の始めに1回だけ実行するには秒と分に0を使います",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "指定した時間の毎分に実行されます。正時に1回だけ実行するには0を使います",
+	"The step %s only matches %s":                                                        "ステップ%sは%sにしか一致しません",
+	"Hours go from 0 to 23, midnight is 0":                                               "時は0から23までで、午前0時は0です",
+	"Ranges do not wrap around, %s is written %s":                                        "範囲は折り返せません。%sは%sと書きます",
+	"Day names go in the day of week field":                                              "曜日名は曜日フィールドに書きます",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "これはQuartzの式です。Unixのcronには秒、年、?はありません",
+	"L cannot be combined with a step":                                                   "Lはステップと組み合わせられません",
+	"The %s is missing":                                                                  "%sのフィールドがありません",
+	"The %s '%s' is not valid":                                                           "%sの値「%s」は無効です",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "ステップ%sは%sを割り切れず、間隔の1つが%sしかありません",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartzでは両方の日フィールドを指定できません。どちらかに?を使用してください",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "両方の日フィールドが制限されています。Unixのcronはどちらかに一致する日に実行されます",
+	"The expression never fires":                                                         "この式は一度も実行されません",
+	"The expression fires less than once a year":                                         "この式の実行は年に1回未満です",
+	", every %s days of the week":                                                        "、週の%s日ごと",
+	", %s through %s":                                                                    "、%sから%sまで",
+	", every %s months":                                                                  "、%sか月ごと",
+	", every month":                                                                      "、毎月",
+	", only in %s":                                                                       "、%sのみ",
+	", only in year %s":                                                                  "、%s年のみ",
+	", on %s":                                                                            "、%s",
+	", in %s":                                                                            "、%s",
+	", in year %s":                                                                       "、%s年",
+	", on the last day of the month":                                                     "、毎月末日",
+	", on the last weekday of the month":                                                 "、毎月最終平日",
+	"first weekday":                                                                      "最初の平日",
+	"weekday nearest the %s":                                                             "%sに最も近い平日",
+	", on the %s of the month":                                                           "、毎月%s",
+	", on the %s day of the month":                                                       "、毎月%s",
+	", between the %s and %s of the month":                                               "、毎月%sから%sまで",
+	", every %s days":                                                                    "、%s日ごと",
+	", every %s years":                                                                   "、%s年ごと",
+	", every year":                                                                       "、毎年",
+	" (expired — last ran in %s)":                                                        "（期限切れ — 最終実行は%s年）",
+	" (approximately)":                                                                   "（おおよそ）",
+	" (never fires)":                                                                     "（実行されません）",
+	" (fires less than once a year)":                                                     "（実行は年に1回未満）",
+	", starting in %s":                                                                   "、%s年から",
+	", starting in %s, until %s":                                                         "、%s年から%s年まで",
+	", this year only":                                                                   "、今年のみ",
+	", next year only":                                                                   "、来年のみ",
+	", e.g. %s":                                                                          "、例：%s",
+	"%s o'clock":                                                                         "%s時",
+	"%s:%s spoken":                                                                       "%s時%s分",
+	"%s:0%s spoken":                                                                      "%s時%s分",
+	"%s:%s:%s spoken":                                                                    "%s時%s分%s秒",
+	"%s a.m.":                                                                            "午前%s",
+	"%s p.m.":                                                                            "午後%s",
+	", starting %s":                                                                      "、%sから開始",
+	"every quarter hour":                                                                 "15分ごと",
+	"every half hour":                                                                    "30分ごと",
+	"every hour during business hours":                                                   "営業時間中の毎時",
+	"At midnight":                                                                        "午前0時に",
+	"At noon":                                                                            "正午に",
+	" on weekdays":                                                                       "、平日",
+	" on weekends":                                                                       "、週末",
+	"monthly":                                                                            "毎月",
+	"quarterly":                                                                          "四半期ごと",
+	"yearly":                                                                             "毎年",
+	", on the first day of every quarter":                                                "、各四半期の初日",
+	"every %s sec":                                                                       "%s秒ごと",
+	"every %s min":                                                                       "%s分ごと",
+	"every %s h":                                                                         "%s時間ごと",
+	"hourly":                                                                             "毎時",
+	"last day":                                                                           "最終日",
+	"last weekday":                                                                       "最終平日",
+	"nearest weekday to the %s":                                                          "%sに最も近い平日",
+	"every %s days":                                                                      "%s日ごと",
+	"every %s days from the %s":                                                          "%[2]sから%[1]s日ごと",
+	"last %s":                                                                            "最終%s曜",
+	"%s %s":                                                                              "%s%s曜",
+	" and ":                                                                              "と",
+	", and ":                                                                             "と",
+	", every minute":                                                                     "、毎分",
+	", every hour":                                                                       "、毎時",
+	"Sunday":                                                                             "日曜日",
+	"Monday":                                                                             "月曜日",
+	"Tuesday":                                                                            "火曜日",
+	"Wednesday":                                                                          "水曜日",
+	"Thursday":                                                                           "木曜日",
+	"Friday":                                                                             "金曜日",
+	"Saturday":                                                                           "土曜日",
+	"January":                                                                            "1月",
+	"February":                                                                           "2月",
+	"March":                                                                              "3月",
+	"April":                                                                              "4月",
+	"May":                                                                                "5月",
+	"June":                                                                               "6月",
+	"July":                                                                               "7月",
+	"August":                                                                             "8月",
+	"September":                                                                          "9月",
+	"October":                                                                            "10月",
+	"November":                                                                           "11月",
+	"December":                                                                           "12月",
+}
+
+var jaJPDayAbbreviations = []string{"日", "月", "火", "水", "木", "金", "土"}
+
+var jaJPMonthAbbreviations = []string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"}
@@ -0,0 +1,139 @@
+package locale
+
+var koKR = map[string]string{
+	"At %s":                                 "%s에",
+	"Every minute between %s and %s":        "%s부터 %s까지 매분",
+	"every second":                          "매초",
+	"every %s seconds":                      "%s초마다",
+	"seconds %s through %s past the minute": "매분 %s초부터 %s초까지",
+	"at %s seconds past the minute":         "매분 %s초에",
+	"every minute":                          "매분",
+	"every %s minutes":                      "%s분마다",
+	"minutes %s through %s past the hour":   "매시 %s분부터 %s분까지",
+	"at %s minutes past the hour":           "매시 %s분에",
+	"every hour":                            "매시간",
+	"every %s hours":                        "%s시간마다",
+	"between %s and %s":                     "%s부터 %s까지",
+	"at %s":                                 "%s에",
+	", on the %s %s of the month":           ", 매월 %s %s",
+	", on the last %s of the month":         ", 매월 마지막 %s",
+	", only on %s":                          ", %s에만",
+	", every day":                           ", 매일",
+	", on any day of the month":             ", 월의 모든 날",
+	", on any day of the week":              ", 모든 요일",
+	", on any %s":                           ", 매주 %s",
+	"%s or %s":                              "%s 또는 %s",
+	"second (field)":                        "초",
+	"minute (field)":                        "분",
+	"hour (field)":                          "시",
+	"day of month (field)":                  "일",
+	"month (field)":                         "월",
+	"day of week (field)":                   "요일",
+	"year (field)":                          "연도",
+	"[invalid %s '%s']":                     "[잘못된 %s '%s']",
+	"[missing %s]":                          "[%s 없음]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "지정한 분의 매초마다 실행됩니다. 분의 시작에 한 번만 실행하려면 0을 사용하세요",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "지정한 시간의 매초마다 실행됩니다. 시간의 시작에 한 번만 실행하려면 초와 분에 0을 사용하세요",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "지정한 시간의 매분마다 실행됩니다. 정각에 한 번만 실행하려면 0을 사용하세요",
+	"The step %s only matches %s":                                                        "간격 %s은(는) %s에만 일치합니다",
+	"Hours go from 0 to 23, midnight is 0":                                               "시는 0부터 23까지이며 자정은 0입니다",
+	"Ranges do not wrap around, %s is written %s":                                        "범위는 끝을 넘어 이어지지 않습니다. %s은(는) %s(으)로 씁니다",
+	"Day names go in the day of week field":                                              "요일 이름은 요일 필드에 씁니다",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "Quartz 표현식입니다. Unix cron에는 초, 연도, ?가 없습니다",
+	"L cannot be combined with a step":                                                   "L은 간격과 함께 사용할 수 없습니다",
+	"The %s is missing":                                                                  "%s 필드가 없습니다",
+	"The %s '%s' is not valid":                                                           "%s 값 '%s'이(가) 잘못되었습니다",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "간격 %s(으)로는 %s이(가) 고르게 나누어지지 않아 한 간격이 %s뿐입니다",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartz는 두 일 필드를 모두 허용하지 않으므로 그중 하나에 ?를 사용하세요",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "두 일 필드가 모두 제한되어 있어 Unix cron은 둘 중 하나에 일치하는 날에 실행됩니다",
+	"The expression never fires":                                                         "이 식은 실행되지 않습니다",
+	"The expression fires less than once a year":                                         "이 식은 1년에 한 번 미만으로 실행됩니다",
+	", every %s days of the week":                                                        ", 주 %s일마다",
+	", %s through %s":                                                                    ", %s부터 %s까지",
+	", every %s months":                                                                  ", %s개월마다",
+	", every month":                                                                      ", 매월",
+	", only in %s":                                                                       ", %s에만",
+	", only in year %s":                                                                  ", %s년에만",
+	", on %s":                                                                            ", %s에",
+	", in %s":                                                                            ", %s에",
+	", in year %s":                                                                       ", %s년에",
+	", on the last day of the month":                                                     ", 매월 마지막 날",
+	", on the last weekday of the month":                                                 ", 매월 마지막 평일",
+	"first weekday":                                                                      "첫 번째 평일",
+	"weekday nearest the %s":                                                             "%s에 가장 가까운 평일",
+	", on the %s of the month":                                                           ", 매월 %s",
+	", on the %s day of the month":                                                       ", 매월 %s",
+	", between the %s and %s of the month":                                               ", 매월 %s부터 %s까지",
+	", every %s days":                                                                    ", %s일마다",
+	", every %s years":                                                                   ", %s년마다",
+	", every year":                                                                       ", 매년",
+	" (expired — last ran in %s)":                                                        " (만료됨 — 마지막 실행 %s년)",
+	" (approximately)":                                                                   " (대략)",
+	" (never fires)":                                                                     " (실행되지 않음)",
+	" (fires less than once a year)":                                                     " (1년에 한 번 미만 실행)",
+	", starting in %s":                                                                   ", %s년부터",
+	", starting in %s, until %s":                                                         ", %s년부터 %s년까지",
+	", this year only":                                                                   ", 올해만",
+	", next year only":                                                                   ", 내년만",
+	", e.g. %s":                                                                          ", 예: %s",
+	"%s o'clock":                                                                         "%s 시",
+	"%s:%s spoken":                                                                       "%s 시 %s 분",
+	"%s:0%s spoken":                                                                      "%s 시 %s 분",
+	"%s:%s:%s spoken":                                                                    "%s 시 %s 분 %s 초",
+	"%s a.m.":                                                                            "오전 %s",
+	"%s p.m.":                                                                            "오후 %s",
+	", starting %s":                                                                      ", %s부터 시작",
+	"every quarter hour":                                                                 "15분마다",
+	"every half hour":                                                                    "30분마다",
+	"every hour during business hours":                                                   "업무 시간 중 매시간",
+	"At midnight":                                                                        "자정에",
+	"At noon":                                                                            "정오에",
+	" on weekdays":                                                                       ", 평일",
+	" on weekends":                                                                       ", 주말",
+	"monthly":                                                                            "매월",
+	"quarterly":                                                                          "분기마다",
+	"yearly":                                                                             "매년",
+	", on the first day of every quarter":                                                ", 매 분기 첫날",
+	"every %s sec":                                                                       "%s초마다",
+	"every %s min":                                                                       "%s분마다",
+	"every %s h":                                                                         "%s시간마다",
+	"hourly":                                                                             "매시간",
+	"last day":                                                                           "마지막 날",
+	"last weekday":                                                                       "마지막 평일",
+	"nearest weekday to the %s":                                                          "%s에 가장 가까운 평일",
+	"every %s days":                                                                      "%s일마다",
+	"every %s days from the %s":                                                          "%[2]s부터 %[1]s일마다",
+	"last %s":                                                                            "마지막 %s",
+	" and ":                                                                              " 및 ",
+	", and ":                                                                             " 및 ",
+	", every minute":                                                                     ", 매분",
+	", every hour":                                                                       ", 매시간",
+	"Sunday":                                                                             "일요일",
+	"Monday":                                                                             "월요일",
+	"Tuesday":                                                                            "화요일",
+	"Wednesday":                                                                          "수요일",
+	"Thursday":                                                                           "목요일",
+	"Friday":                                                                             "금요일",
+	"Saturday":                                                                           "토요일",
+	"January":                                                                            "1월",
+	"February":                                                                           "2월",
+	"March":                                                                              "3월",
+	"April":                                                                              "4월",
+	"May":                                                                                "5월",
+	"June":                                                                               "6월",
+	"July":                                                                               "7월",
+	"August":                                                                             "8월",
+	"September":                                                                          "9월",
+	"October":                                                                            "10월",
+	"November":                                                                           "11월",
+	"December":                                                                           "12월",
+}
+
+var koKRCountGenders = map[string]Gender{
+	"every %s hours": Feminine,
+	"%s o'clock":     Feminine,
+}
+
+var koKRDayAbbreviations = []string{"일", "월", "화", "수", "목", "금", "토"}
+
+var koKRMonthAbbreviations = []string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"}
@@ -0,0 +1,134 @@
+package locale
+
+var nlNL = map[string]string{
+	"At %s":                                 "Om %s",
+	"Every minute between %s and %s":        "Elke minuut tussen %s en %s",
+	"every second":                          "elke seconde",
+	"every %s seconds":                      "elke %s seconden",
+	"seconds %s through %s past the minute": "seconden %s tot en met %s na de minuut",
+	"at %s seconds past the minute":         "op %s seconden na de minuut",
+	"every minute":                          "elke minuut",
+	"every %s minutes":                      "elke %s minuten",
+	"minutes %s through %s past the hour":   "minuten %s tot en met %s na het uur",
+	"at %s minutes past the hour":           "op %s minuten na het uur",
+	"every hour":                            "elk uur",
+	"every %s hours":                        "elke %s uur",
+	"between %s and %s":                     "tussen %s en %s",
+	"at %s":                                 "om %s",
+	", on the %s %s of the month":           ", op de %s %s van de maand",
+	", on the last %s of the month":         ", op de laatste %s van de maand",
+	", only on %s":                          ", alleen op %s",
+	", every day":                           ", elke dag",
+	", on any day of the month":             ", op elke dag van de maand",
+	", on any day of the week":              ", op elke dag van de week",
+	", on any %s":                           ", op elke %s",
+	"%s or %s":                              "%s of %s",
+	"second (field)":                        "seconde",
+	"minute (field)":                        "minuut",
+	"hour (field)":                          "uur",
+	"day of month (field)":                  "dag van de maand",
+	"month (field)":                         "maand",
+	"day of week (field)":                   "dag van de week",
+	"year (field)":                          "jaar",
+	"[invalid %s '%s']":                     "[%s ongeldig: ‘%s’]",
+	"[missing %s]":                          "[%s ontbreekt]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "Draait elke seconde van de opgegeven minuten, gebruik 0 om één keer aan het begin van de minuut te draaien",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "Draait elke seconde van de opgegeven uren, gebruik 0 voor de seconden en minuten om één keer aan het begin van het uur te draaien",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "Draait elke minuut van de opgegeven uren, gebruik 0 om één keer aan het begin van het uur te draaien",
+	"The step %s only matches %s":                                                        "De stap %s komt alleen overeen met %s",
+	"Hours go from 0 to 23, midnight is 0":                                               "Uren lopen van 0 tot 23, middernacht is 0",
+	"Ranges do not wrap around, %s is written %s":                                        "Bereiken lopen niet rond, %s schrijf je als %s",
+	"Day names go in the day of week field":                                              "Dagnamen horen in het veld dag van de week",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "Dit is een Quartz-expressie, Unix-cron kent geen seconden, jaren of ?",
+	"L cannot be combined with a step":                                                   "L kan niet met een stap worden gecombineerd",
+	"The %s is missing":                                                                  "Het veld %s ontbreekt",
+	"The %s '%s' is not valid":                                                           "Het veld %s is ongeldig: ‘%s’",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "De stap %s deelt %s niet gelijkmatig, één tussenpoos is maar %s",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartz staat niet beide dagvelden toe, gebruik ? in een van beide",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "Beide dagvelden zijn beperkt, Unix-cron draait op de dagen die met een van beide overeenkomen",
+	"The expression never fires":                                                         "De expressie wordt nooit uitgevoerd",
+	"The expression fires less than once a year":                                         "De expressie wordt minder dan eens per jaar uitgevoerd",
+	", every %s days of the week":                                                        ", elke %s dagen van de week",
+	", %s through %s":                                                                    ", %s tot en met %s",
+	", every %s months":                                                                  ", elke %s maanden",
+	", every month":                                                                      ", elke maand",
+	", only in %s":                                                                       ", alleen in %s",
+	", only in year %s":                                                                  ", alleen in %s",
+	", on %s":                                                                            ", op %s",
+	", in %s":                                                                            ", in %s",
+	", in year %s":                                                                       ", in %s",
+	", on the last day of the month":                                                     ", op de laatste dag van de maand",
+	", on the last weekday of the month":                                                 ", op de laatste werkdag van de maand",
+	"first weekday":                                                                      "eerste werkdag",
+	"weekday nearest the %s":                                                             "werkdag het dichtst bij de %s",
+	", on the %s of the month":                                                           ", op de %s van de maand",
+	", on the %s day of the month":                                                       ", op de %s van de maand",
+	", between the %s and %s of the month":                                               ", tussen de %s en de %s van de maand",
+	", every %s days":                                                                    ", elke %s dagen",
+	", every %s years":                                                                   ", elke %s jaar",
+	", every year":                                                                       ", elk jaar",
+	" (expired — last ran in %s)":                                                        " (verlopen — laatst uitgevoerd in %s)",
+	" (approximately)":                                                                   " (ongeveer)",
+	" (never fires)":                                                                     " (wordt nooit uitgevoerd)",
+	" (fires less than once a year)":                                                     " (wordt minder dan eens per jaar uitgevoerd)",
+	", starting in %s":                                                                   ", vanaf %s",
+	", starting in %s, until %s":                                                         ", vanaf %s tot en met %s",
+	", this year only":                                                                   ", alleen dit jaar",
+	", next year only":                                                                   ", alleen volgend jaar",
+	", e.g. %s":                                                                          ", bijv. %s",
+	"%s o'clock":                                                                         "%s uur",
+	"%s:%s spoken":                                                                       "%s uur %s",
+	"%s:0%s spoken":                                                                      "%s uur %s",
+	"%s:%s:%s spoken":                                                                    "%s uur %s en %s seconden",
+	"%s a.m.":                                                                            "%s 's ochtends",
+	"%s p.m.":                                                                            "%s 's middags",
+	", starting %s":                                                                      ", beginnend %s",
+	"every quarter hour":                                                                 "elk kwartier",
+	"every half hour":                                                                    "elk half uur",
+	"every hour during business hours":                                                   "elk uur tijdens kantooruren",
+	"At midnight":                                                                        "Om middernacht",
+	"At noon":                                                                            "Om het middaguur",
+	" on weekdays":                                                                       " op werkdagen",
+	" on weekends":                                                                       " in het weekend",
+	"monthly":                                                                            "maandelijks",
+	"quarterly":                                                                          "elk kwartaal",
+	"yearly":                                                                             "jaarlijks",
+	", on the first day of every quarter":                                                ", op de eerste dag van elk kwartaal",
+	"every %s sec":                                                                       "elke %s sec.",
+	"every %s min":                                                                       "elke %s min.",
+	"every %s h":                                                                         "elke %s uur",
+	"hourly":                                                                             "elk uur",
+	"last day":                                                                           "laatste dag",
+	"last weekday":                                                                       "laatste werkdag",
+	"nearest weekday to the %s":                                                          "werkdag het dichtst bij de %s",
+	"every %s days":                                                                      "elke %s dagen",
+	"every %s days from the %s":                                                          "elke %s dagen vanaf de %s",
+	"last %s":                                                                            "laatste %s",
+	" and ":                                                                              " en ",
+	", and ":                                                                             " en ",
+	", every minute":                                                                     ", elke minuut",
+	", every hour":                                                                       ", elk uur",
+	"Sunday":                                                                             "zondag",
+	"Monday":                                                                             "maandag",
+	"Tuesday":                                                                            "dinsdag",
+	"Wednesday":                                                                          "woensdag",
+	"Thursday":                                                                           "donderdag",
+	"Friday":                                                                             "vrijdag",
+	"Saturday":                                                                           "zaterdag",
+	"January":                                                                            "januari",
+	"February":                                                                           "februari",
+	"March":                                                                              "maart",
+	"April":                                                                              "april",
+	"May":                                                                                "mei",
+	"June":                                                                               "juni",
+	"July":                                                                               "juli",
+	"August":                                                                             "augustus",
+	"September":                                                                          "september",
+	"October":                                                                            "oktober",
+	"November":                                                                           "november",
+	"December":                                                                           "december",
+}
+
+var nlNLDayAbbreviations = []string{"zo", "ma", "di", "wo", "do", "vr", "za"}
+
+var nlNLMonthAbbreviations = []string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"}
@@ -0,0 +1,467 @@
+package locale
+
+import (
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+)
+
+// The words of numbers from 0 to 59, enough for any count, minute or second
+// of an expression, built from the words up to 19 (29 in Spanish) and the
+// tens. Locales without words keep their digits, even in speakable descriptions:
+// Arabic and Hebrew have none yet, their numbers agree with the counted noun in
+// ways the lists cannot capture (Arabic gender polarity and duals, the Hebrew
+// construct forms such as "שתי שעות"). Korean counts with Sino-Korean numbers,
+// its feminine words are the native ones hours are counted and told with, up to 24.
+
+// maxNumberWord is the largest number with words
+const maxNumberWord = 59
+
+type dayPeriod struct {
+	fromHour int
+	key      string
+}
+
+type numberWords struct {
+	//the words below the first ten with a list of its own, by gender
+	unitList map[Gender][]string
+	//the words of the tens, index 2 is twenty
+	tenList []string
+	//joins the tens and the unit, i.e. "twenty-one", "einundzwanzig"
+	fnJoin func(ten string, unit string, unitNumber int) string
+}
+
+var (
+	//cardinal numbers counting something, i.e. "every two hours"
+	numberWordList = map[int]numberWords{
+		EN_US: {
+			unitList: map[Gender][]string{Masculine: {"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+				"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}},
+			tenList: []string{"", "", "twenty", "thirty", "forty", "fifty"},
+			fnJoin:  func(ten, unit string, _ int) string { return ten + "-" + unit },
+		},
+		DE_DE: {
+			unitList: map[Gender][]string{Masculine: {"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun", "zehn",
+				"elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn"}},
+			tenList: []string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig"},
+			fnJoin: func(ten, unit string, unitNumber int) string {
+				if unitNumber == 1 {
+					unit = "ein"
+				}
+				return unit + "und" + ten
+			},
+		},
+		FR_FR: {
+			unitList: map[Gender][]string{
+				Masculine: {"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf", "dix",
+					"onze", "douze", "treize", "quatorze", "quinze", "seize", "dix-sept", "dix-huit", "dix-neuf"},
+				Feminine: {"zéro", "une", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf", "dix",
+					"onze", "douze", "treize", "quatorze", "quinze", "seize", "dix-sept", "dix-huit", "dix-neuf"},
+			},
+			tenList: []string{"", "", "vingt", "trente", "quarante", "cinquante"},
+			fnJoin: func(ten, unit string, unitNumber int) string {
+				if unitNumber == 1 {
+					return ten + " et " + unit
+				}
+				return ten + "-" + unit
+			},
+		},
+		ES_ES: {
+			unitList: map[Gender][]string{
+				Masculine: {"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve", "diez",
+					"once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve",
+					"veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve"},
+				Feminine: {"cero", "una", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve", "diez",
+					"once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve",
+					"veinte", "veintiuna", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve"},
+			},
+			tenList: []string{"", "", "veinte", "treinta", "cuarenta", "cincuenta"},
+			fnJoin:  func(ten, unit string, _ int) string { return ten + " y " + unit },
+		},
+		IT_IT: {
+			unitList: map[Gender][]string{Masculine: {"zero", "uno", "due", "tre", "quattro", "cinque", "sei", "sette", "otto", "nove", "dieci",
+				"undici", "dodici", "tredici", "quattordici", "quindici", "sedici", "diciassette", "diciotto", "diciannove"}},
+			tenList: []string{"", "", "venti", "trenta", "quaranta", "cinquanta"},
+			fnJoin: func(ten, unit string, unitNumber int) string {
+				if unitNumber == 1 || unitNumber == 8 {
+					ten = ten[:len(ten)-1]
+				} else if unitNumber == 3 {
+					unit = "tré"
+				}
+				return ten + unit
+			},
+		},
+		PT_BR: {
+			unitList: map[Gender][]string{
+				Masculine: {"zero", "um", "dois", "três", "quatro", "cinco", "seis", "sete", "oito", "nove", "dez",
+					"onze", "doze", "treze", "catorze", "quinze", "dezesseis", "dezessete", "dezoito", "dezenove"},
+				Feminine: {"zero", "uma", "duas", "três", "quatro", "cinco", "seis", "sete", "oito", "nove", "dez",
+					"onze", "doze", "treze", "catorze", "quinze", "dezesseis", "dezessete", "dezoito", "dezenove"},
+			},
+			tenList: []string{"", "", "vinte", "trinta", "quarenta", "cinquenta"},
+			fnJoin:  func(ten, unit string, _ int) string { return ten + " e " + unit },
+		},
+		NL_NL: {
+			unitList: map[Gender][]string{Masculine: {"nul", "een", "twee", "drie", "vier", "vijf", "zes", "zeven", "acht", "negen", "tien",
+				"elf", "twaalf", "dertien", "veertien", "vijftien", "zestien", "zeventien", "achttien", "negentien"}},
+			tenList: []string{"", "", "twintig", "dertig", "veertig", "vijftig"},
+			fnJoin: func(ten, unit string, _ int) string {
+				//"tweeëntwintig", "drieëntwintig"
+				if strings.HasSuffix(unit, "e") {
+					return unit + "ën" + ten
+				}
+				return unit + "en" + ten
+			},
+		},
+		RU_RU: {
+			unitList: map[Gender][]string{
+				Masculine: {"ноль", "один", "два", "три", "четыре", "пять", "шесть", "семь", "восемь", "девять", "десять",
+					"одиннадцать", "двенадцать", "тринадцать", "четырнадцать", "пятнадцать", "шестнадцать", "семнадцать", "восемнадцать", "девятнадцать"},
+				//accusative, the case counts are used in ("каждую одну минуту")
+				Feminine: {"ноль", "одну", "две", "три", "четыре", "пять", "шесть", "семь", "восемь", "девять", "десять",
+					"одиннадцать", "двенадцать", "тринадцать", "четырнадцать", "пятнадцать", "шестнадцать", "семнадцать", "восемнадцать", "девятнадцать"},
+			},
+			tenList: []string{"", "", "двадцать", "тридцать", "сорок", "пятьдесят"},
+			fnJoin:  func(ten, unit string, _ int) string { return ten + " " + unit },
+		},
+		PL_PL: {
+			unitList: map[Gender][]string{
+				Masculine: {"zero", "jeden", "dwa", "trzy", "cztery", "pięć", "sześć", "siedem", "osiem", "dziewięć", "dziesięć",
+					"jedenaście", "dwanaście", "trzynaście", "czternaście", "piętnaście", "szesnaście", "siedemnaście", "osiemnaście", "dziewiętnaście"},
+				Feminine: {"zero", "jedną", "dwie", "trzy", "cztery", "pięć", "sześć", "siedem", "osiem", "dziewięć", "dziesięć",
+					"jedenaście", "dwanaście", "trzynaście", "czternaście", "piętnaście", "szesnaście", "siedemnaście", "osiemnaście", "dziewiętnaście"},
+			},
+			tenList: []string{"", "", "dwadzieścia", "trzydzieści", "czterdzieści", "pięćdziesiąt"},
+			fnJoin: func(ten, unit string, unitNumber int) string {
+				//compounds keep "jeden" whatever the gender
+				if unitNumber == 1 {
+					unit = "jeden"
+				}
+				return ten + " " + unit
+			},
+		},
+		ZH_CN: {
+			unitList: map[Gender][]string{Masculine: {"零", "一", "两", "三", "四", "五", "六", "七", "八", "九", "十",
+				"十一", "十二", "十三", "十四", "十五", "十六", "十七", "十八", "十九"}},
+			tenList: []string{"", "", "二十", "三十", "四十", "五十"},
+			fnJoin:  joinChineseNumber,
+		},
+		ZH_TW: {
+			unitList: map[Gender][]string{Masculine: {"零", "一", "兩", "三", "四", "五", "六", "七", "八", "九", "十",
+				"十一", "十二", "十三", "十四", "十五", "十六", "十七", "十八", "十九"}},
+			tenList: []string{"", "", "二十", "三十", "四十", "五十"},
+			fnJoin:  joinChineseNumber,
+		},
+		JA_JP: {
+			unitList: map[Gender][]string{Masculine: {"零", "一", "二", "三", "四", "五", "六", "七", "八", "九", "十",
+				"十一", "十二", "十三", "十四", "十五", "十六", "十七", "十八", "十九"}},
+			tenList: []string{"", "", "二十", "三十", "四十", "五十"},
+			fnJoin:  joinChineseNumber,
+		},
+		KO_KR: {
+			unitList: map[Gender][]string{
+				Masculine: {"영", "일", "이", "삼", "사", "오", "육", "칠", "팔", "구", "십",
+					"십일", "십이", "십삼", "십사", "십오", "십육", "십칠", "십팔", "십구"},
+				Feminine: {"영", "한", "두", "세", "네", "다섯", "여섯", "일곱", "여덟", "아홉", "열",
+					"열한", "열두", "열세", "열네", "열다섯", "열여섯", "열일곱", "열여덟", "열아홉", "스무",
+					"스물한", "스물두", "스물세", "스물네"},
+			},
+			tenList: []string{"", "", "이십", "삼십", "사십", "오십"},
+			fnJoin:  func(ten, unit string, _ int) string { return ten + unit },
+		},
+		FA_IR: {
+			unitList: map[Gender][]string{Masculine: {"صفر", "یک", "دو", "سه", "چهار", "پنج", "شش", "هفت", "هشت", "نه", "ده",
+				"یازده", "دوازده", "سیزده", "چهارده", "پانزده", "شانزده", "هفده", "هجده", "نوزده"}},
+			tenList: []string{"", "", "بیست", "سی", "چهل", "پنجاه"},
+			fnJoin:  func(ten, unit string, _ int) string { return ten + " و " + unit },
+		},
+	}
+
+	//the numbers in another case than the nominative where the messages take them,
+	//i.e. the genitive after "с" and "до" ("с семи часов утра")
+	numberFormWordList = map[int]map[Form]numberWords{
+		RU_RU: {
+			Genitive: {
+				unitList: map[Gender][]string{
+					Masculine: {"ноля", "одного", "двух", "трёх", "четырёх", "пяти", "шести", "семи", "восьми", "девяти", "десяти",
+						"одиннадцати", "двенадцати", "тринадцати", "четырнадцати", "пятнадцати", "шестнадцати", "семнадцати", "восемнадцати", "девятнадцати"},
+					Feminine: {"ноля", "одной", "двух", "трёх", "четырёх", "пяти", "шести", "семи", "восьми", "девяти", "десяти",
+						"одиннадцати", "двенадцати", "тринадцати", "четырнадцати", "пятнадцати", "шестнадцати", "семнадцати", "восемнадцати", "девятнадцати"},
+				},
+				tenList: []string{"", "", "двадцати", "тридцати", "сорока", "пятидесяти"},
+				fnJoin:  func(ten, unit string, _ int) string { return ten + " " + unit },
+			},
+		},
+	}
+
+	//ordinals of the nth weekday of the month in the case ordinalFormatList uses,
+	//index 0 is unused
+	ordinalWordList = map[int]map[Gender][]string{
+		EN_US: {Masculine: {"", "first", "second", "third", "fourth", "fifth"}},
+		DE_DE: {Masculine: {"", "ersten", "zweiten", "dritten", "vierten", "fünften"}},
+		FR_FR: {
+			Masculine: {"", "premier", "deuxième", "troisième", "quatrième", "cinquième"},
+			Feminine:  {"", "première", "deuxième", "troisième", "quatrième", "cinquième"},
+		},
+		ES_ES: {Masculine: {"", "primer", "segundo", "tercer", "cuarto", "quinto"}},
+		IT_IT: {
+			Masculine: {"", "primo", "secondo", "terzo", "quarto", "quinto"},
+			Feminine:  {"", "prima", "seconda", "terza", "quarta", "quinta"},
+		},
+		PT_BR: {
+			Masculine: {"", "primeiro", "segundo", "terceiro", "quarto", "quinto"},
+			Feminine:  {"", "primeira", "segunda", "terceira", "quarta", "quinta"},
+		},
+		NL_NL: {Masculine: {"", "eerste", "tweede", "derde", "vierde", "vijfde"}},
+		RU_RU: {
+			Masculine: {"", "первый", "второй", "третий", "четвёртый", "пятый"},
+			Feminine:  {"", "первую", "вторую", "третью", "четвёртую", "пятую"},
+			Neuter:    {"", "первое", "второе", "третье", "четвёртое", "пятое"},
+		},
+		PL_PL: {
+			Masculine: {"", "pierwszy", "drugi", "trzeci", "czwarty", "piąty"},
+			Feminine:  {"", "pierwszą", "drugą", "trzecią", "czwartą", "piątą"},
+		},
+		ZH_CN: {Masculine: {"", "第一个", "第二个", "第三个", "第四个", "第五个"}},
+		ZH_TW: {Masculine: {"", "第一個", "第二個", "第三個", "第四個", "第五個"}},
+		JA_JP: {Masculine: {"", "第一", "第二", "第三", "第四", "第五"}},
+		FA_IR: {Masculine: {"", "اولین", "دومین", "سومین", "چهارمین", "پنجمین"}},
+	}
+
+	//the day of the month as dayOrdinalFormatList writes it. Romance languages
+	//name the days with cardinals but the first.
+	dayOrdinalWordList = map[int]func(number int) (string, bool){
+		EN_US: func(number int) (string, bool) {
+			unitList := []string{"", "first", "second", "third", "fourth", "fifth", "sixth", "seventh", "eighth", "ninth", "tenth",
+				"eleventh", "twelfth", "thirteenth", "fourteenth", "fifteenth", "sixteenth", "seventeenth", "eighteenth", "nineteenth"}
+			tenList := []string{"", "", "twentieth", "thirtieth"}
+			if number < len(unitList) {
+				return unitList[number], true
+			} else if number%10 == 0 {
+				return tenList[number/10], true
+			}
+			ten, _ := NumberWord(EN_US, number/10*10, Masculine)
+			return ten + "-" + unitList[number%10], true
+		},
+		DE_DE: func(number int) (string, bool) {
+			//dative, "am ersten"
+			switch number {
+			case 1:
+				return "ersten", true
+			case 3:
+				return "dritten", true
+			case 7:
+				return "siebten", true
+			case 8:
+				return "achten", true
+			}
+			word, ok := NumberWord(DE_DE, number, Masculine)
+			if number >= 20 {
+				return word + "sten", ok
+			}
+			return word + "ten", ok
+		},
+		FR_FR: romanceDayOrdinal(FR_FR, "premier"),
+		ES_ES: romanceDayOrdinal(ES_ES, "primero"),
+		IT_IT: romanceDayOrdinal(IT_IT, "primo"),
+		PT_BR: romanceDayOrdinal(PT_BR, "primeiro"),
+		NL_NL: func(number int) (string, bool) {
+			switch number {
+			case 1:
+				return "eerste", true
+			case 3:
+				return "derde", true
+			case 8:
+				return "achtste", true
+			}
+			word, ok := NumberWord(NL_NL, number, Masculine)
+			if number >= 20 {
+				return word + "ste", ok
+			}
+			return word + "de", ok
+		},
+		PL_PL: func(number int) (string, bool) {
+			//genitive, "pierwszego dnia"
+			unitList := []string{"", "pierwszego", "drugiego", "trzeciego", "czwartego", "piątego", "szóstego", "siódmego", "ósmego", "dziewiątego", "dziesiątego",
+				"jedenastego", "dwunastego", "trzynastego", "czternastego", "piętnastego", "szesnastego", "siedemnastego", "osiemnastego", "dziewiętnastego"}
+			tenList := []string{"", "", "dwudziestego", "trzydziestego"}
+			if number < len(unitList) {
+				return unitList[number], true
+			} else if number%10 == 0 {
+				return tenList[number/10], true
+			}
+			return tenList[number/10] + " " + unitList[number%10], true
+		},
+		ZH_CN: suffixedDayOrdinal(JA_JP, "号"),
+		ZH_TW: suffixedDayOrdinal(JA_JP, "號"),
+		JA_JP: suffixedDayOrdinal(JA_JP, "日"),
+		FA_IR: func(number int) (string, bool) {
+			if number == 1 {
+				return "اول", true
+			}
+			word, ok := NumberWord(FA_IR, number, Masculine)
+			if strings.HasSuffix(word, "سه") {
+				return strings.TrimSuffix(word, "سه") + "سوم", ok
+			} else if strings.HasSuffix(word, "سی") {
+				return word + "\u200cام", ok
+			}
+			return word + "م", ok
+		},
+	}
+
+	//the hours of a spoken time of day where they are not said with the cardinals
+	//above, German says "ein Uhr" and Polish feminine ordinals in the locative ("o dziesiątej")
+	hourWordList = map[int][]string{
+		DE_DE: {"null", "ein", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun", "zehn",
+			"elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn",
+			"zwanzig", "einundzwanzig", "zweiundzwanzig", "dreiundzwanzig", "vierundzwanzig"},
+		PL_PL: {"zerowej", "pierwszej", "drugiej", "trzeciej", "czwartej", "piątej", "szóstej", "siódmej", "ósmej", "dziewiątej", "dziesiątej",
+			"jedenastej", "dwunastej", "trzynastej", "czternastej", "piętnastej", "szesnastej", "siedemnastej", "osiemnastej", "dziewiętnastej",
+			"dwudziestej", "dwudziestej pierwszej", "dwudziestej drugiej", "dwudziestej trzeciej", "dwudziestej czwartej"},
+	}
+
+	//the gender of what a message counts, messages not listed count masculine nouns
+	countGenderList = map[int]map[string]Gender{
+		FR_FR: frFRCountGenders,
+		ES_ES: esESCountGenders,
+		PT_BR: ptBRCountGenders,
+		RU_RU: ruRUCountGenders,
+		PL_PL: plPLCountGenders,
+		KO_KR: koKRCountGenders,
+	}
+
+	//the parts of the day a spoken time of day is said with rather than a.m. and p.m.,
+	//by the hour they start at (i.e. "одиннадцать часов вечера")
+	dayPeriodList = map[int][]dayPeriod{
+		RU_RU: {
+			{0, "%s at night"},
+			{4, "%s in the morning"},
+			{12, "%s in the afternoon"},
+			{17, "%s in the evening"},
+		},
+	}
+
+	pluralSuffixList = map[plural.Form]string{
+		plural.Zero: "@zero",
+		plural.One:  "@one",
+		plural.Two:  "@two",
+		plural.Few:  "@few",
+		plural.Many: "@many",
+	}
+)
+
+func joinChineseNumber(ten, unit string, _ int) string {
+	//"两" only counts on its own, in compounds it is "二"
+	if unit == "两" || unit == "兩" {
+		unit = "二"
+	}
+	return ten + unit
+}
+
+func romanceDayOrdinal(localeType int, first string) func(number int) (string, bool) {
+	return func(number int) (string, bool) {
+		if number == 1 {
+			return first, true
+		}
+		return NumberWord(localeType, number, Masculine)
+	}
+}
+
+func suffixedDayOrdinal(localeType int, suffix string) func(number int) (string, bool) {
+	return func(number int) (string, bool) {
+		word, ok := NumberWord(localeType, number, Masculine)
+		return word + suffix, ok
+	}
+}
+
+// NumberWord returns the word for a number up to 59 agreeing with the gender of what it counts
+func NumberWord(localeType int, number int, gender Gender) (string, bool) {
+	words, ok := numberWordList[localeType]
+	if !ok {
+		return "", false
+	}
+	return numberWord(words, number, gender)
+}
+
+// InflectedNumberWord returns the word for a number up to 59 in the form the message takes
+// it in, the nominative when the locale has no words for that form
+func InflectedNumberWord(localeType int, number int, gender Gender, form Form) (string, bool) {
+	if words, ok := numberFormWordList[localeType][form]; ok {
+		return numberWord(words, number, gender)
+	}
+	return NumberWord(localeType, number, gender)
+}
+
+func numberWord(words numberWords, number int, gender Gender) (string, bool) {
+	if number < 0 || number > maxNumberWord {
+		return "", false
+	}
+	unitList, ok := words.unitList[gender]
+	if !ok {
+		unitList = words.unitList[Masculine]
+	}
+
+	if number < len(unitList) {
+		return unitList[number], true
+	} else if number%10 == 0 {
+		return words.tenList[number/10], true
+	}
+	return words.fnJoin(words.tenList[number/10], unitList[number%10], number%10), true
+}
+
+// HourWord returns the word for the hour of a spoken time of day in the form the message
+// takes it in
+func HourWord(localeType int, hour int, gender Gender, form Form) (string, bool) {
+	if wordList, ok := hourWordList[localeType]; ok {
+		if hour < 0 || hour >= len(wordList) {
+			return "", false
+		}
+		return wordList[hour], true
+	}
+	return InflectedNumberWord(localeType, hour, gender, form)
+}
+
+// DayPeriodKey returns the message saying a spoken time of day with the part of the day
+// of the hour (0 to 23), ok is false when the locale says a.m. and p.m.
+func DayPeriodKey(localeType int, hour int) (string, bool) {
+	key, ok := "", false
+	for _, period := range dayPeriodList[localeType] {
+		if hour >= period.fromHour {
+			key, ok = period.key, true
+		}
+	}
+	return key, ok
+}
+
+// OrdinalWord returns the word for the ordinal of the nth weekday agreeing with the gender of the day
+func OrdinalWord(localeType int, number int, gender Gender) (string, bool) {
+	genderList := ordinalWordList[localeType]
+	wordList, ok := genderList[gender]
+	if !ok {
+		wordList = genderList[Masculine]
+	}
+	if number < 1 || number >= len(wordList) {
+		return "", false
+	}
+	return wordList[number], true
+}
+
+// DayOrdinalWord returns the word for a day of the month
+func DayOrdinalWord(localeType int, number int) (string, bool) {
+	fnDayOrdinal, ok := dayOrdinalWordList[localeType]
+	if !ok || number < 1 || number > 31 {
+		return "", false
+	}
+	return fnDayOrdinal(number)
+}
+
+// CountGender returns the gender of what the message counts
+func CountGender(localeType int, key string) Gender {
+	return countGenderList[localeType][key]
+}
+
+// PluralKey returns the key the message variant for the plural form of its count is stored under,
+// plural.Other is the message itself
+func PluralKey(key string, form plural.Form) string {
+	return key + pluralSuffixList[form]
+}
@@ -0,0 +1,68 @@
+package locale
+
+import (
+	"fmt"
+
+	"golang.org/x/text/feature/plural"
+)
+
+var (
+	//ordinal formats chosen by the CLDR ordinal plural form of the number,
+	//a form missing in the list falls back to plural.Other
+	ordinalFormatList = map[int]map[Gender]map[plural.Form]string{
+		EN_US: {Masculine: {plural.One: "%dst", plural.Two: "%dnd", plural.Few: "%drd", plural.Other: "%dth"}},
+		DE_DE: {Masculine: {plural.Other: "%d."}},
+		FR_FR: {Masculine: {plural.One: "%der", plural.Other: "%de"}, Feminine: {plural.One: "%dre", plural.Other: "%de"}},
+		ES_ES: {Masculine: {plural.Other: "%d.º"}, Feminine: {plural.Other: "%d.ª"}},
+		IT_IT: {Masculine: {plural.Other: "%dº"}, Feminine: {plural.Other: "%dª"}},
+		PT_BR: {Masculine: {plural.Other: "%dº"}, Feminine: {plural.Other: "%dª"}},
+		NL_NL: {Masculine: {plural.Other: "%de"}},
+		//accusative, the only case ordinals are used in
+		RU_RU: {Masculine: {plural.Other: "%d-й"}, Feminine: {plural.Other: "%d-ю"}, Neuter: {plural.Other: "%d-е"}},
+		PL_PL: {Masculine: {plural.Other: "%d."}},
+		ZH_CN: {Masculine: {plural.Other: "第%d个"}},
+		ZH_TW: {Masculine: {plural.Other: "第%d個"}},
+		JA_JP: {Masculine: {plural.Other: "第%d"}},
+		KO_KR: {Masculine: {plural.Other: "%d번째"}},
+		FA_IR: {Masculine: {plural.Other: "%dامین"}},
+	}
+
+	//the day of the month, i.e. "the 15th", "le 15", "15日"
+	dayOrdinalFormatList = map[int]map[plural.Form]string{
+		EN_US: {plural.One: "%dst", plural.Two: "%dnd", plural.Few: "%drd", plural.Other: "%dth"},
+		DE_DE: {plural.Other: "%d."},
+		FR_FR: {plural.One: "%der", plural.Other: "%d"},
+		NL_NL: {plural.Other: "%de"},
+		PL_PL: {plural.Other: "%d."},
+		ZH_CN: {plural.Other: "%d号"},
+		ZH_TW: {plural.Other: "%d號"},
+		JA_JP: {plural.Other: "%d日"},
+		KO_KR: {plural.Other: "%d일"},
+	}
+)
+
+// Ordinal returns the ordinal of the number agreeing with the gender, i.e. "3rd", "3ª"
+func Ordinal(localeType int, number int, gender Gender) string {
+	formatList, ok := ordinalFormatList[localeType][gender]
+	if !ok {
+		formatList = ordinalFormatList[localeType][Masculine]
+	}
+	return formatOrdinal(localeType, formatList, number)
+}
+
+// DayOrdinal returns the ordinal used for a day of the month
+func DayOrdinal(localeType int, number int) string {
+	return formatOrdinal(localeType, dayOrdinalFormatList[localeType], number)
+}
+
+func formatOrdinal(localeType int, formatList map[plural.Form]string, number int) string {
+	form := plural.Ordinal.MatchPlural(Tag(localeType), number, 0, 0, 0, 0)
+	format, ok := formatList[form]
+	if !ok {
+		format, ok = formatList[plural.Other]
+	}
+	if !ok {
+		format = "%d"
+	}
+	return fmt.Sprintf(format, number)
+}
@@ -0,0 +1,153 @@
+package locale
+
+import "testing"
+
+func TestOrdinal(t *testing.T) {
+	testList := []struct {
+		localeType int
+		number     int
+		gender     Gender
+		expected   string
+	}{
+		{EN_US, 1, Masculine, "1st"},
+		{EN_US, 2, Masculine, "2nd"},
+		{EN_US, 3, Masculine, "3rd"},
+		{EN_US, 11, Masculine, "11th"},
+		{EN_US, 12, Masculine, "12th"},
+		{EN_US, 13, Masculine, "13th"},
+		{EN_US, 21, Masculine, "21st"},
+		{EN_US, 22, Masculine, "22nd"},
+		{FR_FR, 1, Feminine, "1re"},
+		{IT_IT, 1, Feminine, "1ª"},
+		{RU_RU, 3, Feminine, "3-ю"},
+		{RU_RU, 3, Neuter, "3-е"},
+		{DE_DE, 3, Feminine, "3."},
+		{AR_SA, 3, Masculine, "3"},
+	}
+
+	for _, val := range testList {
+		if ordinal := Ordinal(val.localeType, val.number, val.gender); ordinal != val.expected {
+			t.Errorf("locale %d, %d: expected %q, got %q", val.localeType, val.number, val.expected, ordinal)
+		}
+	}
+}
+
+func TestDayOrdinal(t *testing.T) {
+	testList := []struct {
+		localeType int
+		number     int
+		expected   string
+	}{
+		{EN_US, 15, "15th"},
+		{EN_US, 31, "31st"},
+		{FR_FR, 1, "1er"},
+		{FR_FR, 15, "15"},
+		{DE_DE, 15, "15."},
+		{JA_JP, 15, "15日"},
+	}
+
+	for _, val := range testList {
+		if ordinal := DayOrdinal(val.localeType, val.number); ordinal != val.expected {
+			t.Errorf("locale %d, %d: expected %q, got %q", val.localeType, val.number, val.expected, ordinal)
+		}
+	}
+}
+
+func TestDayOrdinalWord(t *testing.T) {
+	testList := []struct {
+		localeType int
+		number     int
+		expected   string
+	}{
+		{EN_US, 21, "twenty-first"},
+		{EN_US, 30, "thirtieth"},
+		{DE_DE, 31, "einunddreißigsten"},
+		{NL_NL, 8, "achtste"},
+		{PL_PL, 23, "dwudziestego trzeciego"},
+		{FA_IR, 23, "بیست و سوم"},
+		{ES_ES, 1, "primero"},
+	}
+
+	for _, val := range testList {
+		if word, _ := DayOrdinalWord(val.localeType, val.number); word != val.expected {
+			t.Errorf("locale %d, %d: expected %q, got %q", val.localeType, val.number, val.expected, word)
+		}
+	}
+}
+
+func TestNumberWord(t *testing.T) {
+	testList := []struct {
+		localeType int
+		number     int
+		gender     Gender
+		expected   string
+		ok         bool
+	}{
+		{EN_US, 2, Masculine, "two", true},
+		{EN_US, 21, Masculine, "twenty-one", true},
+		{EN_US, 60, Masculine, "", false},
+		{DE_DE, 21, Masculine, "einundzwanzig", true},
+		{FR_FR, 41, Masculine, "quarante et un", true},
+		{IT_IT, 28, Masculine, "ventotto", true},
+		{NL_NL, 32, Masculine, "tweeëndertig", true},
+		{PL_PL, 21, Feminine, "dwadzieścia jeden", true},
+		{ZH_CN, 22, Masculine, "二十二", true},
+		{RU_RU, 2, Feminine, "две", true},
+		{RU_RU, 2, Neuter, "два", true},
+		{PT_BR, 2, Feminine, "duas", true},
+		{KO_KR, 2, Masculine, "이", true},
+		{KO_KR, 21, Masculine, "이십일", true},
+		{KO_KR, 12, Feminine, "열두", true},
+		{HE_IL, 2, Masculine, "", false},
+		{AR_SA, 2, Masculine, "", false},
+	}
+
+	for _, val := range testList {
+		if word, ok := NumberWord(val.localeType, val.number, val.gender); word != val.expected || ok != val.ok {
+			t.Errorf("locale %d, %d: expected %q, got %q", val.localeType, val.number, val.expected, word)
+		}
+	}
+}
+
+func TestInflectedNumberWord(t *testing.T) {
+	testList := []struct {
+		localeType int
+		number     int
+		gender     Gender
+		form       Form
+		expected   string
+	}{
+		{RU_RU, 7, Masculine, Genitive, "семи"},
+		{RU_RU, 1, Feminine, Genitive, "одной"},
+		{RU_RU, 55, Masculine, Genitive, "пятидесяти пяти"},
+		{RU_RU, 7, Masculine, Nominative, "семь"},
+		{EN_US, 7, Masculine, Genitive, "seven"},
+	}
+
+	for _, val := range testList {
+		if word, _ := InflectedNumberWord(val.localeType, val.number, val.gender, val.form); word != val.expected {
+			t.Errorf("locale %d, %d: expected %q, got %q", val.localeType, val.number, val.expected, word)
+		}
+	}
+}
+
+func TestDayPeriodKey(t *testing.T) {
+	testList := []struct {
+		localeType int
+		hour       int
+		expected   string
+		ok         bool
+	}{
+		{RU_RU, 2, "%s at night", true},
+		{RU_RU, 7, "%s in the morning", true},
+		{RU_RU, 13, "%s in the afternoon", true},
+		{RU_RU, 23, "%s in the evening", true},
+		{EN_US, 23, "", false},
+	}
+
+	for _, val := range testList {
+		if key, ok := DayPeriodKey(val.localeType, val.hour); key != val.expected || ok != val.ok {
+			t.Errorf("locale %d, %d: expected %q, got %q", val.localeType, val.hour, val.expected, key)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package locale
+
+var plPL = map[string]string{
+	"At %s":                                  "O %s",
+	"Every minute between %s and %s":         "Co minutę od %s do %s",
+	"every second":                           "co sekundę",
+	"every %s seconds":                       "co %s sekund",
+	"every %s seconds@few":                   "co %s sekundy",
+	"seconds %s through %s past the minute":  "sekundy od %s do %s każdej minuty",
+	"at %s seconds past the minute":          "w %s sekundzie minuty",
+	"every minute":                           "co minutę",
+	"every %s minutes":                       "co %s minut",
+	"every %s minutes@few":                   "co %s minuty",
+	"minutes %s through %s past the hour":    "minuty od %s do %s każdej godziny",
+	"at %s minutes past the hour":            "w %s minucie godziny",
+	"every hour":                             "co godzinę",
+	"every %s hours":                         "co %s godzin",
+	"every %s hours@few":                     "co %s godziny",
+	"between %s and %s":                      "od %s do %s",
+	"at %s":                                  "o %s",
+	", on the %s %s of the month":            ", w %s %s miesiąca",
+	", on the last %s of the month":          ", w ostatni %s miesiąca",
+	", on the last %s of the month@feminine": ", w ostatnią %s miesiąca",
+	", only on %s":                           ", tylko w %s",
+	", every day":                            ", codziennie",
+	", on any day of the month":              ", w dowolny dzień miesiąca",
+	", on any day of the week":               ", w dowolny dzień tygodnia",
+	", on any %s":                            ", w dowolny %s",
+	", on any %s@feminine":                   ", w dowolną %s",
+	"%s or %s":                               "%s lub %s",
+	"second (field)":                         "sekunda",
+	"minute (field)":                         "minuta",
+	"hour (field)":                           "godzina",
+	"day of month (field)":                   "dzień miesiąca",
+	"month (field)":                          "miesiąc",
+	"day of week (field)":                    "dzień tygodnia",
+	"year (field)":                           "rok",
+	"[invalid %s '%s']":                      "[%s: nieprawidłowa wartość „%s”]",
+	"[missing %s]":                           "[brak pola: %s]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "Uruchamia się co sekundę w podanych minutach, użyj 0, aby uruchomić raz na początku minuty",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "Uruchamia się co sekundę w podanych godzinach, użyj 0 dla sekund i minut, aby uruchomić raz na początku godziny",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "Uruchamia się co minutę w podanych godzinach, użyj 0, aby uruchomić raz na początku godziny",
+	"The step %s only matches %s":                                                        "Krok %s pasuje tylko do %s",
+	"Hours go from 0 to 23, midnight is 0":                                               "Godziny mają wartości od 0 do 23, północ to 0",
+	"Ranges do not wrap around, %s is written %s":                                        "Zakresy nie zawijają się, %s zapisuje się jako %s",
+	"Day names go in the day of week field":                                              "Nazwy dni tygodnia należą do pola dnia tygodnia",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "To jest wyrażenie Quartz, cron w Uniksie nie ma sekund, lat ani ?",
+	"L cannot be combined with a step":                                                   "L nie może być łączone z krokiem",
+	"The %s is missing":                                                                  "Brak pola %s",
+	"The %s '%s' is not valid":                                                           "Pole %s ma nieprawidłową wartość „%s”",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "Krok %s nie dzieli %s równo, jeden odstęp ma tylko %s",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartz nie pozwala na oba pola dnia, użyj ? w jednym z nich",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "Oba pola dnia są ograniczone, cron w Uniksie działa w dni pasujące do któregokolwiek z nich",
+	"The expression never fires":                                                         "Wyrażenie nigdy się nie uruchamia",
+	"The expression fires less than once a year":                                         "Wyrażenie uruchamia się rzadziej niż raz w roku",
+	", every %s days of the week":                                                        ", co %s dni tygodnia",
+	", %s through %s":                                                                    ", od %s do %s",
+	", every %s months":                                                                  ", co %s miesięcy",
+	", every %s months@few":                                                              ", co %s miesiące",
+	", every month":                                                                      ", co miesiąc",
+	", only in %s":                                                                       ", tylko w %s",
+	", only in year %s":                                                                  ", tylko w roku %s",
+	", on %s":                                                                            ", w %s",
+	", in %s":                                                                            ", w %s",
+	", in year %s":                                                                       ", w roku %s",
+	", on the last day of the month":                                                     ", w ostatni dzień miesiąca",
+	", on the last weekday of the month":                                                 ", w ostatni dzień roboczy miesiąca",
+	"first weekday":                                                                      "pierwszy dzień roboczy",
+	"weekday nearest the %s":                                                             "dzień roboczy najbliższy %s dnia",
+	", on the %s of the month":                                                           ", w %s miesiąca",
+	", on the %s day of the month":                                                       ", %s dnia miesiąca",
+	", between the %s and %s of the month":                                               ", od %s do %s dnia miesiąca",
+	", every %s days":                                                                    ", co %s dni",
+	", every %s years":                                                                   ", co %s lat",
+	", every %s years@few":                                                               ", co %s lata",
+	", every year":                                                                       ", co roku",
+	" (expired — last ran in %s)":                                                        " (wygasło — ostatnio uruchomione w %s)",
+	" (approximately)":                                                                   " (w przybliżeniu)",
+	" (never fires)":                                                                     " (nigdy się nie uruchamia)",
+	" (fires less than once a year)":                                                     " (uruchamia się rzadziej niż raz w roku)",
+	", starting in %s":                                                                   ", począwszy od %s",
+	", starting in %s, until %s":                                                         ", począwszy od %s do %s",
+	", this year only":                                                                   ", tylko w tym roku",
+	", next year only":                                                                   ", tylko w przyszłym roku",
+	", e.g. %s":                                                                          ", np. %s",
+	"%s o'clock":                                                                         "%s",
+	"%s:%s spoken":                                                                       "%s %s",
+	"%s:0%s spoken":                                                                      "%s zero %s",
+	"%s:%s:%s spoken":                                                                    "%s %s i %s sekund",
+	"%s a.m.":                                                                            "%s rano",
+	"%s p.m.":                                                                            "%s po południu",
+	", starting %s":                                                                      ", zaczynając %s",
+	"every quarter hour":                                                                 "co kwadrans",
+	"every half hour":                                                                    "co pół godziny",
+	"every hour during business hours":                                                   "co godzinę w godzinach pracy",
+	"At midnight":                                                                        "O północy",
+	"At noon":                                                                            "W południe",
+	" on weekdays":                                                                       " w dni robocze",
+	" on weekends":                                                                       " w weekendy",
+	"monthly":                                                                            "co miesiąc",
+	"quarterly":                                                                          "co kwartał",
+	"yearly":                                                                             "co rok",
+	", on the first day of every quarter":                                                ", pierwszego dnia każdego kwartału",
+	"every %s sec":                                                                       "co %s s",
+	"every %s min":                                                                       "co %s min",
+	"every %s h":                                                                         "co %s godz.",
+	"hourly":                                                                             "co godzinę",
+	"last day":                                                                           "ostatni dzień",
+	"last weekday":                                                                       "ostatni dzień roboczy",
+	"nearest weekday to the %s":                                                          "dzień roboczy najbliższy %s",
+	"every %s days":                                                                      "co %s dni",
+	"every %s days from the %s":                                                          "co %s dni od %s",
+	"last %s":                                                                            "ost. %s",
+	" and ":                                                                              " i ",
+	", and ":                                                                             " i ",
+	", every minute":                                                                     ", co minutę",
+	", every hour":                                                                       ", co godzinę",
+	"Sunday":                                                                             "niedziela",
+	"Monday":                                                                             "poniedziałek",
+	"Tuesday":                                                                            "wtorek",
+	"Wednesday":                                                                          "środa",
+	"Thursday":                                                                           "czwartek",
+	"Friday":                                                                             "piątek",
+	"Saturday":                                                                           "sobota",
+	"January":                                                                            "styczeń",
+	"February":                                                                           "luty",
+	"March":                                                                              "marzec",
+	"April":                                                                              "kwiecień",
+	"May":                                                                                "maj",
+	"June":                                                                               "czerwiec",
+	"July":                                                                               "lipiec",
+	"August":                                                                             "sierpień",
+	"September":                                                                          "wrzesień",
+	"October":                                                                            "październik",
+	"November":                                                                           "listopad",
+	"December":                                                                           "grudzień",
+}
+
+var plPLDayForms = map[Form][]string{
+	Nominative: {"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+	Accusative: {"niedzielę", "poniedziałek", "wtorek", "środę", "czwartek", "piątek", "sobotę"},
+	Genitive:   {"niedzieli", "poniedziałku", "wtorku", "środy", "czwartku", "piątku", "soboty"},
+	Plural:     {"niedziele", "poniedziałki", "wtorki", "środy", "czwartki", "piątki", "soboty"},
+}
+
+var plPLMonthForms = map[Form][]string{
+	Nominative: {"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+	Genitive:   {"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca", "lipca", "sierpnia", "września", "października", "listopada", "grudnia"},
+	Locative:   {"styczniu", "lutym", "marcu", "kwietniu", "maju", "czerwcu", "lipcu", "sierpniu", "wrześniu", "październiku", "listopadzie", "grudniu"},
+}
+
+var plPLDayGenders = []Gender{Feminine, Masculine, Masculine, Feminine, Masculine, Masculine, Feminine}
+
+var plPLMessageForms = map[string][]Form{
+	", on the %s %s of the month":   {Nominative, Accusative},
+	", on the last %s of the month": {Accusative},
+	", only on %s":                  {Plural},
+	", on any %s":                   {Accusative},
+	", on %s":                       {Plural},
+	", %s through %s":               {Genitive, Genitive},
+	", only in %s":                  {Locative},
+	", in %s":                       {Locative},
+}
+
+var plPLCountGenders = map[string]Gender{
+	"every %s seconds": Feminine,
+	"every %s minutes": Feminine,
+	"every %s hours":   Feminine,
+}
+
+var plPLDayAbbreviations = []string{"niedz.", "pon.", "wt.", "śr.", "czw.", "pt.", "sob."}
+
+var plPLMonthAbbreviations = []string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"}
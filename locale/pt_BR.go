@@ -0,0 +1,157 @@
+package locale
+
+var ptBR = map[string]string{
+	"At %s":                                  "Às %s",
+	"Every minute between %s and %s":         "A cada minuto entre %s e %s",
+	"every second":                           "a cada segundo",
+	"every %s seconds":                       "a cada %s segundos",
+	"seconds %s through %s past the minute":  "segundos %s até %s de cada minuto",
+	"at %s seconds past the minute":          "aos %s segundos do minuto",
+	"every minute":                           "a cada minuto",
+	"every %s minutes":                       "a cada %s minutos",
+	"minutes %s through %s past the hour":    "minutos %s até %s de cada hora",
+	"at %s minutes past the hour":            "aos %s minutos da hora",
+	"every hour":                             "a cada hora",
+	"every %s hours":                         "a cada %s horas",
+	"between %s and %s":                      "entre %s e %s",
+	"at %s":                                  "às %s",
+	", on the %s %s of the month":            ", no %s %s do mês",
+	", on the %s %s of the month@feminine":   ", na %s %s do mês",
+	", on the last %s of the month":          ", no último %s do mês",
+	", on the last %s of the month@feminine": ", na última %s do mês",
+	", only on %s":                           ", somente aos %s",
+	", only on %s@feminine":                  ", somente às %s",
+	", every day":                            ", todos os dias",
+	", on any day of the month":              ", em qualquer dia do mês",
+	", on any day of the week":               ", em qualquer dia da semana",
+	", on any %s":                            ", em qualquer %s",
+	"%s or %s":                               "%s ou %s",
+	"second (field)":                         "segundo",
+	"minute (field)":                         "minuto",
+	"hour (field)":                           "hora",
+	"day of month (field)":                   "dia do mês",
+	"month (field)":                          "mês",
+	"day of week (field)":                    "dia da semana",
+	"year (field)":                           "ano",
+	"[invalid %s '%s']":                      "[%s: “%s” não é válido]",
+	"[missing %s]":                           "[falta: %s]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "Executa a cada segundo dos minutos indicados, use 0 para executar uma vez no início do minuto",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "Executa a cada segundo das horas indicadas, use 0 nos segundos e minutos para executar uma vez no início da hora",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "Executa a cada minuto das horas indicadas, use 0 para executar uma vez no início da hora",
+	"The step %s only matches %s":                                                        "O passo %s corresponde apenas a %s",
+	"Hours go from 0 to 23, midnight is 0":                                               "As horas vão de 0 a 23, meia-noite é 0",
+	"Ranges do not wrap around, %s is written %s":                                        "Intervalos não dão a volta, %s se escreve %s",
+	"Day names go in the day of week field":                                              "Nomes de dias vão no campo dia da semana",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "Esta é uma expressão Quartz, o cron do Unix não tem segundos, anos nem ?",
+	"L cannot be combined with a step":                                                   "L não pode ser combinado com um passo",
+	"The %s is missing":                                                                  "Falta o campo %s",
+	"The %s '%s' is not valid":                                                           "O campo %s não é válido: “%s”",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "O passo %s não divide %s igualmente, um intervalo tem apenas %s",
+	"Quartz does not allow both day fields, use ? in one of them":                        "O Quartz não permite os dois campos de dia, use ? em um deles",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "Os dois campos de dia estão restritos, o cron do Unix é executado nos dias que correspondem a qualquer um deles",
+	"The expression never fires":                                                         "A expressão nunca é executada",
+	"The expression fires less than once a year":                                         "A expressão é executada menos de uma vez por ano",
+	", every %s days of the week":                                                        ", a cada %s dias da semana",
+	", %s through %s":                                                                    ", de %s a %s",
+	", every %s months":                                                                  ", a cada %s meses",
+	", every month":                                                                      ", todo mês",
+	", only in %s":                                                                       ", somente em %s",
+	", only in year %s":                                                                  ", somente em %s",
+	", on %s":                                                                            ", aos %s",
+	", on %s@feminine":                                                                   ", às %s",
+	", in %s":                                                                            ", em %s",
+	", in year %s":                                                                       ", em %s",
+	", on the last day of the month":                                                     ", no último dia do mês",
+	", on the last weekday of the month":                                                 ", no último dia útil do mês",
+	"first weekday":                                                                      "primeiro dia útil",
+	"weekday nearest the %s":                                                             "dia útil mais próximo do dia %s",
+	", on the %s of the month":                                                           ", no %s do mês",
+	", on the %s day of the month":                                                       ", no dia %s do mês",
+	", on the %s days of the month":                                                      ", nos dias %s do mês",
+	", between the %s and %s of the month":                                               ", entre os dias %s e %s do mês",
+	", every %s days":                                                                    ", a cada %s dias",
+	", every %s years":                                                                   ", a cada %s anos",
+	", every year":                                                                       ", todo ano",
+	" (expired — last ran in %s)":                                                        " (expirado — última execução em %s)",
+	" (approximately)":                                                                   " (aproximadamente)",
+	" (never fires)":                                                                     " (nunca é executada)",
+	" (fires less than once a year)":                                                     " (é executada menos de uma vez por ano)",
+	", starting in %s":                                                                   ", a partir de %s",
+	", starting in %s, until %s":                                                         ", a partir de %s até %s",
+	", this year only":                                                                   ", somente este ano",
+	", next year only":                                                                   ", somente no próximo ano",
+	", e.g. %s":                                                                          ", p. ex. %s",
+	"%s o'clock":                                                                         "%s horas",
+	"%s o'clock@one":                                                                     "%s hora",
+	"%s:%s spoken":                                                                       "%s e %s",
+	"%s:0%s spoken":                                                                      "%s e %s",
+	"%s:%s:%s spoken":                                                                    "%s e %s e %s segundos",
+	"%s a.m.":                                                                            "%s da manhã",
+	"%s p.m.":                                                                            "%s da tarde",
+	", starting %s":                                                                      ", começando %s",
+	"every quarter hour":                                                                 "a cada quinze minutos",
+	"every half hour":                                                                    "a cada meia hora",
+	"every hour during business hours":                                                   "a cada hora durante o horário comercial",
+	"At midnight":                                                                        "À meia-noite",
+	"At noon":                                                                            "Ao meio-dia",
+	" on weekdays":                                                                       " em dias úteis",
+	" on weekends":                                                                       " nos fins de semana",
+	"monthly":                                                                            "mensalmente",
+	"quarterly":                                                                          "trimestralmente",
+	"yearly":                                                                             "anualmente",
+	", on the first day of every quarter":                                                ", no primeiro dia de cada trimestre",
+	"every %s sec":                                                                       "a cada %s s",
+	"every %s min":                                                                       "a cada %s min",
+	"every %s h":                                                                         "a cada %s h",
+	"hourly":                                                                             "de hora em hora",
+	"last day":                                                                           "último dia",
+	"last weekday":                                                                       "último dia útil",
+	"nearest weekday to the %s":                                                          "dia útil mais próximo do %s",
+	"every %s days":                                                                      "a cada %s dias",
+	"every %s days from the %s":                                                          "a cada %s dias a partir do %s",
+	"last %s":                                                                            "último %s",
+	"last %s@feminine":                                                                   "última %s",
+	" and ":                                                                              " e ",
+	", and ":                                                                             " e ",
+	", every minute":                                                                     ", a cada minuto",
+	", every hour":                                                                       ", a cada hora",
+	"Sunday":                                                                             "domingo",
+	"Monday":                                                                             "segunda-feira",
+	"Tuesday":                                                                            "terça-feira",
+	"Wednesday":                                                                          "quarta-feira",
+	"Thursday":                                                                           "quinta-feira",
+	"Friday":                                                                             "sexta-feira",
+	"Saturday":                                                                           "sábado",
+	"January":                                                                            "janeiro",
+	"February":                                                                           "fevereiro",
+	"March":                                                                              "março",
+	"April":                                                                              "abril",
+	"May":                                                                                "maio",
+	"June":                                                                               "junho",
+	"July":                                                                               "julho",
+	"August":                                                                             "agosto",
+	"September":                                                                          "setembro",
+	"October":                                                                            "outubro",
+	"November":                                                                           "novembro",
+	"December":                                                                           "dezembro",
+}
+
+var ptBRDayForms = map[Form][]string{
+	Plural: {"domingos", "segundas-feiras", "terças-feiras", "quartas-feiras", "quintas-feiras", "sextas-feiras", "sábados"},
+}
+
+var ptBRDayGenders = []Gender{Masculine, Feminine, Feminine, Feminine, Feminine, Feminine, Masculine}
+
+var ptBRMessageForms = map[string][]Form{
+	", only on %s": {Plural},
+	", on %s":      {Plural},
+}
+
+var ptBRCountGenders = map[string]Gender{
+	"every %s hours": Feminine,
+	"%s o'clock":     Feminine,
+}
+
+var ptBRDayAbbreviations = []string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."}
+
+var ptBRMonthAbbreviations = []string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."}
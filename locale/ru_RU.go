@@ -0,0 +1,206 @@
+package locale
+
+import "regexp"
+
+var ruRU = map[string]string{
+	"At %s":                                  "В %s",
+	"Every minute between %s and %s":         "Каждую минуту с %s по %s",
+	"every second":                           "каждую секунду",
+	"every %s seconds":                       "каждые %s секунд",
+	"every %s seconds@one":                   "каждую %s секунду",
+	"every %s seconds@few":                   "каждые %s секунды",
+	"seconds %s through %s past the minute":  "с %s по %s секунду минуты",
+	"at %s seconds past the minute":          "на %s секунде минуты",
+	"every minute":                           "каждую минуту",
+	"every %s minutes":                       "каждые %s минут",
+	"every %s minutes@one":                   "каждую %s минуту",
+	"every %s minutes@few":                   "каждые %s минуты",
+	"minutes %s through %s past the hour":    "с %s по %s минуту часа",
+	"at %s minutes past the hour":            "на %s минуте часа",
+	"every hour":                             "каждый час",
+	"every %s hours":                         "каждые %s часов",
+	"every %s hours@one":                     "каждый %s час",
+	"every %s hours@few":                     "каждые %s часа",
+	"between %s and %s":                      "с %s до %s",
+	"at %s":                                  "в %s",
+	", on the %s %s of the month":            ", в %s %s месяца",
+	", on the last %s of the month":          ", в последний %s месяца",
+	", on the last %s of the month@feminine": ", в последнюю %s месяца",
+	", on the last %s of the month@neuter":   ", в последнее %s месяца",
+	", only on %s":                           ", только по %s",
+	", every day":                            ", каждый день",
+	", on any day of the month":              ", в любой день месяца",
+	", on any day of the week":               ", в любой день недели",
+	", on any %s":                            ", в любой %s",
+	", on any %s@feminine":                   ", в любую %s",
+	", on any %s@neuter":                     ", в любое %s",
+	"%s or %s":                               "%s или %s",
+	"second (field)":                         "секунда",
+	"minute (field)":                         "минута",
+	"hour (field)":                           "час",
+	"day of month (field)":                   "день месяца",
+	"month (field)":                          "месяц",
+	"day of week (field)":                    "день недели",
+	"year (field)":                           "год",
+	"[invalid %s '%s']":                      "[%s: недопустимое значение «%s»]",
+	"[missing %s]":                           "[нет поля: %s]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "Выполняется каждую секунду указанных минут, укажите 0, чтобы выполнять один раз в начале минуты",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "Выполняется каждую секунду указанных часов, укажите 0 в секундах и минутах, чтобы выполнять один раз в начале часа",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "Выполняется каждую минуту указанных часов, укажите 0, чтобы выполнять один раз в начале часа",
+	"The step %s only matches %s":                                                        "Шаг %s соответствует только %s",
+	"Hours go from 0 to 23, midnight is 0":                                               "Часы идут от 0 до 23, полночь — это 0",
+	"Ranges do not wrap around, %s is written %s":                                        "Диапазоны не переходят через конец, %s записывается как %s",
+	"Day names go in the day of week field":                                              "Названия дней недели указываются в поле дня недели",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "Это выражение Quartz, в Unix cron нет секунд, лет и ?",
+	"L cannot be combined with a step":                                                   "L нельзя сочетать с шагом",
+	"The %s is missing":                                                                  "Нет поля «%s»",
+	"The %s '%s' is not valid":                                                           "Поле «%s» имеет недопустимое значение «%s»",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "Шаг %s не делит %s нацело, один из промежутков равен всего %s",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartz не допускает оба поля дня, используйте ? в одном из них",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "Оба поля дня ограничены, cron в Unix выполняется в дни, подходящие под любое из них",
+	"The expression never fires":                                                         "Выражение никогда не выполняется",
+	"The expression fires less than once a year":                                         "Выражение выполняется реже одного раза в год",
+	", every %s days of the week":                                                        ", каждые %s дней недели",
+	", every %s days of the week@one":                                                    ", каждый %s день недели",
+	", every %s days of the week@few":                                                    ", каждые %s дня недели",
+	", %s through %s":                                                                    ", с %s по %s",
+	", every %s months":                                                                  ", каждые %s месяцев",
+	", every %s months@one":                                                              ", каждый %s месяц",
+	", every %s months@few":                                                              ", каждые %s месяца",
+	", every month":                                                                      ", каждый месяц",
+	", only in %s":                                                                       ", только в %s",
+	", only in year %s":                                                                  ", только в %s году",
+	", on %s":                                                                            ", по %s",
+	", in %s":                                                                            ", в %s",
+	", in year %s":                                                                       ", в %s году",
+	", on the last day of the month":                                                     ", в последний день месяца",
+	", on the last weekday of the month":                                                 ", в последний будний день месяца",
+	"first weekday":                                                                      "первый будний день",
+	"weekday nearest the %s":                                                             "ближайший будний день к %s-му числу",
+	", on the %s of the month":                                                           ", в %s месяца",
+	", on the %s day of the month":                                                       ", %s-го числа месяца",
+	", on the %s days of the month":                                                      ", %s числа месяца",
+	"%s (day in a list)":                                                                 "%s-го",
+	", between the %s and %s of the month":                                               ", с %s-го по %s-е число месяца",
+	", every %s days":                                                                    ", каждые %s дней",
+	", every %s days@one":                                                                ", каждый %s день",
+	", every %s days@few":                                                                ", каждые %s дня",
+	", every %s years":                                                                   ", каждые %s лет",
+	", every %s years@one":                                                               ", каждый %s год",
+	", every %s years@few":                                                               ", каждые %s года",
+	", every year":                                                                       ", каждый год",
+	" (expired — last ran in %s)":                                                        " (истекло — последний запуск в %s году)",
+	" (approximately)":                                                                   " (приблизительно)",
+	" (never fires)":                                                                     " (никогда не выполняется)",
+	" (fires less than once a year)":                                                     " (выполняется реже одного раза в год)",
+	", starting in %s":                                                                   ", начиная с %s года",
+	", starting in %s, until %s":                                                         ", начиная с %s года по %s год",
+	", this year only":                                                                   ", только в этом году",
+	", next year only":                                                                   ", только в следующем году",
+	", e.g. %s":                                                                          ", например: %s",
+	"%s o'clock":                                                                         "%s часов",
+	"%s o'clock@one":                                                                     "%s час",
+	"%s o'clock@few":                                                                     "%s часа",
+	"%s:%s spoken":                                                                       "%s %s",
+	"%s:0%s spoken":                                                                      "%s ноль %s",
+	"%s:%s:%s spoken":                                                                    "%s %s и %s секунд",
+	"%s a.m.":                                                                            "%s утра",
+	"%s p.m.":                                                                            "%s дня",
+	"%s o'clock@genitive":                                                                "%s часов",
+	"%s o'clock@one@genitive":                                                            "%s часа",
+	"%s o'clock@few@genitive":                                                            "%s часов",
+	"%s at night":                                                                        "%s ночи",
+	"%s in the morning":                                                                  "%s утра",
+	"%s in the afternoon":                                                                "%s дня",
+	"%s in the evening":                                                                  "%s вечера",
+	", starting %s":                                                                      ", начиная %s",
+	"every quarter hour":                                                                 "каждую четверть часа",
+	"every half hour":                                                                    "каждые полчаса",
+	"every hour during business hours":                                                   "каждый час в рабочее время",
+	"At midnight":                                                                        "В полночь",
+	"At noon":                                                                            "В полдень",
+	" on weekdays":                                                                       " по будням",
+	" on weekends":                                                                       " по выходным",
+	"monthly":                                                                            "ежемесячно",
+	"quarterly":                                                                          "ежеквартально",
+	"yearly":                                                                             "ежегодно",
+	", on the first day of every quarter":                                                ", в первый день каждого квартала",
+	"every %s sec":                                                                       "каждые %s с",
+	"every %s min":                                                                       "каждые %s мин",
+	"every %s h":                                                                         "каждые %s ч",
+	"hourly":                                                                             "ежечасно",
+	"last day":                                                                           "последний день",
+	"last weekday":                                                                       "последний рабочий день",
+	"nearest weekday to the %s":                                                          "ближайший рабочий день к %s-му",
+	"every %s days":                                                                      "каждые %s дн.",
+	"every %s days from the %s":                                                          "каждые %s дн. с %s-го",
+	"last %s":                                                                            "посл. %s",
+	" and ":                                                                              " и ",
+	", and ":                                                                             " и ",
+	", every minute":                                                                     ", каждую минуту",
+	", every hour":                                                                       ", каждый час",
+	"Sunday":                                                                             "воскресенье",
+	"Monday":                                                                             "понедельник",
+	"Tuesday":                                                                            "вторник",
+	"Wednesday":                                                                          "среда",
+	"Thursday":                                                                           "четверг",
+	"Friday":                                                                             "пятница",
+	"Saturday":                                                                           "суббота",
+	"January":                                                                            "январь",
+	"February":                                                                           "февраль",
+	"March":                                                                              "март",
+	"April":                                                                              "апрель",
+	"May":                                                                                "май",
+	"June":                                                                               "июнь",
+	"July":                                                                               "июль",
+	"August":                                                                             "август",
+	"September":                                                                          "сентябрь",
+	"October":                                                                            "октябрь",
+	"November":                                                                           "ноябрь",
+	"December":                                                                           "декабрь",
+}
+
+var ruRUDayForms = map[Form][]string{
+	Nominative: {"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+	Accusative: {"воскресенье", "понедельник", "вторник", "среду", "четверг", "пятницу", "субботу"},
+	Genitive:   {"воскресенья", "понедельника", "вторника", "среды", "четверга", "пятницы", "субботы"},
+	Plural:     {"воскресеньям", "понедельникам", "вторникам", "средам", "четвергам", "пятницам", "субботам"},
+}
+
+var ruRUMonthForms = map[Form][]string{
+	Nominative: {"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+	Genitive:   {"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+	Locative:   {"январе", "феврале", "марте", "апреле", "мае", "июне", "июле", "августе", "сентябре", "октябре", "ноябре", "декабре"},
+}
+
+var ruRUDayGenders = []Gender{Neuter, Masculine, Masculine, Feminine, Masculine, Feminine, Feminine}
+
+var ruRUMessageForms = map[string][]Form{
+	"between %s and %s":              {Genitive, Genitive},
+	"Every minute between %s and %s": {Genitive},
+	", on the %s %s of the month":    {Nominative, Accusative},
+	", on the last %s of the month":  {Accusative},
+	", only on %s":                   {Plural},
+	", on any %s":                    {Accusative},
+	", on %s":                        {Plural},
+	", %s through %s":                {Genitive, Accusative},
+	", only in %s":                   {Locative},
+	", in %s":                        {Locative},
+}
+
+var ruRUCountGenders = map[string]Gender{
+	"every %s seconds": Feminine,
+	"every %s minutes": Feminine,
+}
+
+var ruRUDayAbbreviations = []string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"}
+
+var ruRUMonthAbbreviations = []string{"янв.", "февр.", "март", "апр.", "май", "июнь", "июль", "авг.", "сент.", "окт.", "нояб.", "дек."}
+
+// "с" and "в" take a vowel before a cluster that is hard to say after them
+// (i.e. "со среды", "во вторник")
+var ruRUEuphonyRegexp = regexp.MustCompile(`(^|[\s(])(?:([сС]) ([вмсзшж][бвгджзйклмнпрстфхцчшщ])|([вВ]) ([вф][бвгджзйклмнпрстфхцчшщ]))`)
+
+func ruRUEuphony(text string) string {
+	return ruRUEuphonyRegexp.ReplaceAllString(text, "${1}${2}${4}о ${3}${5}")
+}
@@ -1,67 +1,135 @@
 package locale
 
 var zhCN = map[string]string{
-	"An error occured when generating the expression description.  Check the cron": "生成表达式描述时发生了错误，请检查cron表达式语法。",
-	" expression syntax.":                   "生成表达式描述时发生了错误，请检查cron表达式语法。",
-	"At ":                                   "在 ",
-	"At":                                    "在",
-	"Every minute between %s and %s":        "在 %s 和 %s 之间的每分钟",
-	" and":                                  " 和",
+	"At %s":                                 "在%s",
+	"Every minute between %s and %s":        "在%s至%s之间的每分钟",
 	"every second":                          "每秒",
-	"every %s seconds":                      "每 %s 秒",
-	"seconds %s through %s past the minute": "在每分钟的 %s 到 %s 秒",
-	"at %s seconds past the minute":         "在每分钟的 %s 秒",
+	"every %s seconds":                      "每%s秒",
+	"seconds %s through %s past the minute": "每分钟的第%s到%s秒",
+	"at %s seconds past the minute":         "每分钟的第%s秒",
 	"every minute":                          "每分钟",
-	"every %s minutes":                      "每 %s 分钟",
-	"minutes %s through %s past the hour":   "在每小时的 %s 到 %s 分钟",
-	"at %s minutes past the hour":           "在每小时的 %s 分",
+	"every %s minutes":                      "每%s分钟",
+	"minutes %s through %s past the hour":   "每小时的第%s到%s分钟",
+	"at %s minutes past the hour":           "每小时的第%s分钟",
 	"every hour":                            "每小时",
-	"every %s hours":                        "每 %s 小时",
-	"between %s and %s":                     "在 %s 和 %s 之间",
-	"at %s":                                 "在 %s",
-	"first":                                 "第一个",
-	"second":                                "第二个",
-	"third":                                 "第三个",
-	"forth":                                 "第四个",
-	"fifth":                                 "第五个",
-	", on the ":                             ", 在 ",
-	" %s of the month":                      "%s 每月",
-	", on the last %s of the month":         ", 每月的最后一个 %s ",
-	", only on %s":                          ", 仅在 %s",
-	", every day":                           ", 每天",
-	", every %s days of the week":           ", 每周的每 %s 天",
-	", %s through %s":                       ", %s 到 %s",
-	", every %s months":                     ", 每 %s 月",
-	", only in %s":                          ", 仅在 %s",
-	", on the last day of the month":        ", 每月的最后一天",
-	", on the last weekday of the month":    ", 每月的最后一个平日",
-	"first weekday":                         "第一个平日",
-	"weekday nearest day %s":                "最接近 %s 号的平日",
-	", on the %s of the month":              ", 每月的 %s ",
-	", every %s days":                       ", 每 %s 天",
-	", between day %s and %s of the month":  ", 在每月的 %s 和 %s 号之间",
-	", on day %s of the month":              ", 每月的 %s 号",
-	", every %s years":                      ", 每 %s 年",
-	" and ":                                 " 和 ",
-	", every minute":                        ", 每分钟",
-	", every hour":                          ", 每小时",
-	"Sunday":                                "星期日",
-	"Monday":                                "星期一",
-	"Tuesday":                               "星期二",
-	"Wednesday":                             "星期三",
-	"Thursday":                              "星期四",
-	"Friday":                                "星期五",
-	"Saturday":                              "星期六",
-	"January":                               "一月",
-	"February":                              "二月",
-	"March":                                 "三月",
-	"April":                                 "四月",
-	"May":                                   "五月",
-	"June":                                  "六月",
-	"July":                                  "七月",
-	"August":                                "八月",
-	"September":                             "九月",
-	"October":                               "十月",
-	"November":                              "十一月",
-	"December":                              "十二月",
+	"every %s hours":                        "每%s小时",
+	"between %s and %s":                     "在%s至%s之间",
+	"at %s":                                 "在%s",
+	", on the %s %s of the month":           "，每月的%s%s",
+	", on the last %s of the month":         "，每月的最后一个%s",
+	", only on %s":                          "，仅在%s",
+	", every day":                           "，每天",
+	", on any day of the month":             "，每月的任意一天",
+	", on any day of the week":              "，每周的任意一天",
+	", on any %s":                           "，每逢%s",
+	"%s or %s":                              "%s或%s",
+	"second (field)":                        "秒",
+	"minute (field)":                        "分钟",
+	"hour (field)":                          "小时",
+	"day of month (field)":                  "日",
+	"month (field)":                         "月",
+	"day of week (field)":                   "星期",
+	"year (field)":                          "年",
+	"[invalid %s '%s']":                     "[无效的%s“%s”]",
+	"[missing %s]":                          "[缺少%s]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "会在给定分钟内的每一秒运行，用0表示在每分钟开始时运行一次",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "会在给定小时内的每一秒运行，秒和分钟都用0表示在每小时开始时运行一次",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "会在给定小时内的每一分钟运行，用0表示在整点运行一次",
+	"The step %s only matches %s":                                                        "步长%s只匹配%s",
+	"Hours go from 0 to 23, midnight is 0":                                               "小时的取值为0到23，午夜是0",
+	"Ranges do not wrap around, %s is written %s":                                        "范围不会循环，%s应写作%s",
+	"Day names go in the day of week field":                                              "星期名称应写在星期字段中",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "这是Quartz表达式，Unix cron没有秒、年或?",
+	"L cannot be combined with a step":                                                   "L 不能与步长组合使用",
+	"The %s is missing":                                                                  "缺少%s字段",
+	"The %s '%s' is not valid":                                                           "%s的值“%s”无效",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "步长%s不能整除%s，其中一个间隔只有%s",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartz 不允许同时指定两个日期字段，请在其中一个使用 ?",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "两个日期字段都有限制，Unix cron 会在匹配其中任一字段的日期运行",
+	"The expression never fires":                                                         "该表达式永远不会运行",
+	"The expression fires less than once a year":                                         "该表达式每年运行不到一次",
+	", every %s days of the week":                                                        "，每周每%s天",
+	", %s through %s":                                                                    "，%s至%s",
+	", every %s months":                                                                  "，每%s个月",
+	", every month":                                                                      "，每月",
+	", only in %s":                                                                       "，仅在%s",
+	", only in year %s":                                                                  "，仅在%s年",
+	", on %s":                                                                            "，在%s",
+	", in %s":                                                                            "，在%s",
+	", in year %s":                                                                       "，在%s年",
+	", on the last day of the month":                                                     "，每月的最后一天",
+	", on the last weekday of the month":                                                 "，每月的最后一个工作日",
+	"first weekday":                                                                      "第一个工作日",
+	"weekday nearest the %s":                                                             "最接近%s的工作日",
+	", on the %s of the month":                                                           "，每月的%s",
+	", on the %s day of the month":                                                       "，每月的%s",
+	", between the %s and %s of the month":                                               "，每月的%s至%s之间",
+	", every %s days":                                                                    "，每%s天",
+	", every %s years":                                                                   "，每%s年",
+	", every year":                                                                       "，每年",
+	" (expired — last ran in %s)":                                                        "（已过期 — 最后一次运行于%s年）",
+	" (approximately)":                                                                   "（大约）",
+	" (never fires)":                                                                     "（永远不会运行）",
+	" (fires less than once a year)":                                                     "（每年运行不到一次）",
+	", starting in %s":                                                                   "，从%s年开始",
+	", starting in %s, until %s":                                                         "，从%s年开始，到%s年为止",
+	", this year only":                                                                   "，仅限今年",
+	", next year only":                                                                   "，仅限明年",
+	", e.g. %s":                                                                          "，例如%s",
+	"%s o'clock":                                                                         "%s点",
+	"%s:%s spoken":                                                                       "%s点%s分",
+	"%s:0%s spoken":                                                                      "%s点零%s分",
+	"%s:%s:%s spoken":                                                                    "%s点%s分%s秒",
+	"%s a.m.":                                                                            "上午%s",
+	"%s p.m.":                                                                            "下午%s",
+	", starting %s":                                                                      "，从%s开始",
+	"every quarter hour":                                                                 "每刻钟",
+	"every half hour":                                                                    "每半小时",
+	"every hour during business hours":                                                   "工作时间内每小时",
+	"At midnight":                                                                        "在午夜",
+	"At noon":                                                                            "在中午",
+	" on weekdays":                                                                       "，工作日",
+	" on weekends":                                                                       "，周末",
+	"monthly":                                                                            "每月",
+	"quarterly":                                                                          "每季度",
+	"yearly":                                                                             "每年",
+	", on the first day of every quarter":                                                "，每季度的第一天",
+	"every %s sec":                                                                       "每%s秒",
+	"every %s min":                                                                       "每%s分钟",
+	"every %s h":                                                                         "每%s小时",
+	"hourly":                                                                             "每小时",
+	"last day":                                                                           "最后一天",
+	"last weekday":                                                                       "最后一个工作日",
+	"nearest weekday to the %s":                                                          "最接近%s的工作日",
+	"every %s days":                                                                      "每%s天",
+	"every %s days from the %s":                                                          "从%[2]s起每%[1]s天",
+	"last %s":                                                                            "最后一个%s",
+	"%s %s":                                                                              "%s%s",
+	" and ":                                                                              "和",
+	", and ":                                                                             "和",
+	", every minute":                                                                     "，每分钟",
+	", every hour":                                                                       "，每小时",
+	"Sunday":                                                                             "星期日",
+	"Monday":                                                                             "星期一",
+	"Tuesday":                                                                            "星期二",
+	"Wednesday":                                                                          "星期三",
+	"Thursday":                                                                           "星期四",
+	"Friday":                                                                             "星期五",
+	"Saturday":                                                                           "星期六",
+	"January":                                                                            "一月",
+	"February":                                                                           "二月",
+	"March":                                                                              "三月",
+	"April":                                                                              "四月",
+	"May":                                                                                "五月",
+	"June":                                                                               "六月",
+	"July":                                                                               "七月",
+	"August":                                                                             "八月",
+	"September":                                                                          "九月",
+	"October":                                                                            "十月",
+	"November":                                                                           "十一月",
+	"December":                                                                           "十二月",
 }
+
+var zhCNDayAbbreviations = []string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"}
+
+var zhCNMonthAbbreviations = []string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"}
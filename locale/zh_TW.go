@@ -0,0 +1,135 @@
+package locale
+
+var zhTW = map[string]string{
+	"At %s":                                 "在%s",
+	"Every minute between %s and %s":        "在%s至%s之間的每分鐘",
+	"every second":                          "每秒",
+	"every %s seconds":                      "每%s秒",
+	"seconds %s through %s past the minute": "每分鐘的第%s到%s秒",
+	"at %s seconds past the minute":         "每分鐘的第%s秒",
+	"every minute":                          "每分鐘",
+	"every %s minutes":                      "每%s分鐘",
+	"minutes %s through %s past the hour":   "每小時的第%s到%s分鐘",
+	"at %s minutes past the hour":           "每小時的第%s分鐘",
+	"every hour":                            "每小時",
+	"every %s hours":                        "每%s小時",
+	"between %s and %s":                     "在%s至%s之間",
+	"at %s":                                 "在%s",
+	", on the %s %s of the month":           "，每月的%s%s",
+	", on the last %s of the month":         "，每月的最後一個%s",
+	", only on %s":                          "，僅在%s",
+	", every day":                           "，每天",
+	", on any day of the month":             "，每月的任意一天",
+	", on any day of the week":              "，每週的任意一天",
+	", on any %s":                           "，每逢%s",
+	"%s or %s":                              "%s或%s",
+	"second (field)":                        "秒",
+	"minute (field)":                        "分鐘",
+	"hour (field)":                          "小時",
+	"day of month (field)":                  "日",
+	"month (field)":                         "月",
+	"day of week (field)":                   "星期",
+	"year (field)":                          "年",
+	"[invalid %s '%s']":                     "[無效的%s「%s」]",
+	"[missing %s]":                          "[缺少%s]",
+	"Runs every second of the minutes given, use 0 to run once at the start of the minute":                         "會在給定分鐘內的每一秒執行，用0表示在每分鐘開始時執行一次",
+	"Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour": "會在給定小時內的每一秒執行，秒和分鐘都用0表示在每小時開始時執行一次",
+	"Runs every minute of the hours given, use 0 to run once at the start of the hour":                             "會在給定小時內的每一分鐘執行，用0表示在整點執行一次",
+	"The step %s only matches %s":                                                        "步長%s只符合%s",
+	"Hours go from 0 to 23, midnight is 0":                                               "小時的取值為0到23，午夜是0",
+	"Ranges do not wrap around, %s is written %s":                                        "範圍不會循環，%s應寫作%s",
+	"Day names go in the day of week field":                                              "星期名稱應寫在星期欄位中",
+	"This is a Quartz expression, Unix cron has no seconds, years or ?":                  "這是Quartz運算式，Unix cron沒有秒、年或?",
+	"L cannot be combined with a step":                                                   "L 不能與步長組合使用",
+	"The %s is missing":                                                                  "缺少%s欄位",
+	"The %s '%s' is not valid":                                                           "%s的值「%s」無效",
+	"The step %s does not divide %s evenly, one gap is only %s":                          "步長%s不能整除%s，其中一個間隔只有%s",
+	"Quartz does not allow both day fields, use ? in one of them":                        "Quartz 不允許同時指定兩個日期欄位，請在其中一個使用 ?",
+	"Both day fields are restricted, Unix cron runs on the days matching either of them": "兩個日期欄位都有限制，Unix cron 會在符合其中任一欄位的日期執行",
+	"The expression never fires":                                                         "該運算式永遠不會執行",
+	"The expression fires less than once a year":                                         "該運算式每年執行不到一次",
+	", every %s days of the week":                                                        "，每週每%s天",
+	", %s through %s":                                                                    "，%s至%s",
+	", every %s months":                                                                  "，每%s個月",
+	", every month":                                                                      "，每月",
+	", only in %s":                                                                       "，僅在%s",
+	", only in year %s":                                                                  "，僅在%s年",
+	", on %s":                                                                            "，在%s",
+	", in %s":                                                                            "，在%s",
+	", in year %s":                                                                       "，在%s年",
+	", on the last day of the month":                                                     "，每月的最後一天",
+	", on the last weekday of the month":                                                 "，每月的最後一個工作日",
+	"first weekday":                                                                      "第一個工作日",
+	"weekday nearest the %s":                                                             "最接近%s的工作日",
+	", on the %s of the month":                                                           "，每月的%s",
+	", on the %s day of the month":                                                       "，每月的%s",
+	", between the %s and %s of the month":                                               "，每月的%s至%s之間",
+	", every %s days":                                                                    "，每%s天",
+	", every %s years":                                                                   "，每%s年",
+	", every year":                                                                       "，每年",
+	" (expired — last ran in %s)":                                                        "（已過期 — 最後一次執行於%s年）",
+	" (approximately)":                                                                   "（大約）",
+	" (never fires)":                                                                     "（永遠不會執行）",
+	" (fires less than once a year)":                                                     "（每年執行不到一次）",
+	", starting in %s":                                                                   "，從%s年開始",
+	", starting in %s, until %s":                                                         "，從%s年開始，到%s年為止",
+	", this year only":                                                                   "，僅限今年",
+	", next year only":                                                                   "，僅限明年",
+	", e.g. %s":                                                                          "，例如%s",
+	"%s o'clock":                                                                         "%s點",
+	"%s:%s spoken":                                                                       "%s點%s分",
+	"%s:0%s spoken":                                                                      "%s點零%s分",
+	"%s:%s:%s spoken":                                                                    "%s點%s分%s秒",
+	"%s a.m.":                                                                            "上午%s",
+	"%s p.m.":                                                                            "下午%s",
+	", starting %s":                                                                      "，從%s開始",
+	"every quarter hour":                                                                 "每十五分鐘",
+	"every half hour":                                                                    "每半小時",
+	"every hour during business hours":                                                   "上班時間內每小時",
+	"At midnight":                                                                        "在午夜",
+	"At noon":                                                                            "在中午",
+	" on weekdays":                                                                       "，平日",
+	" on weekends":                                                                       "，週末",
+	"monthly":                                                                            "每月",
+	"quarterly":                                                                          "每季",
+	"yearly":                                                                             "每年",
+	", on the first day of every quarter":                                                "，每季的第一天",
+	"every %s sec":                                                                       "每%s秒",
+	"every %s min":                                                                       "每%s分鐘",
+	"every %s h":                                                                         "每%s小時",
+	"hourly":                                                                             "每小時",
+	"last day":                                                                           "最後一天",
+	"last weekday":                                                                       "最後一個工作日",
+	"nearest weekday to the %s":                                                          "最接近%s的工作日",
+	"every %s days":                                                                      "每%s天",
+	"every %s days from the %s":                                                          "從%[2]s起每%[1]s天",
+	"last %s":                                                                            "最後一個%s",
+	"%s %s":                                                                              "%s%s",
+	" and ":                                                                              "和",
+	", and ":                                                                             "和",
+	", every minute":                                                                     "，每分鐘",
+	", every hour":                                                                       "，每小時",
+	"Sunday":                                                                             "星期日",
+	"Monday":                                                                             "星期一",
+	"Tuesday":                                                                            "星期二",
+	"Wednesday":                                                                          "星期三",
+	"Thursday":                                                                           "星期四",
+	"Friday":                                                                             "星期五",
+	"Saturday":                                                                           "星期六",
+	"January":                                                                            "一月",
+	"February":                                                                           "二月",
+	"March":                                                                              "三月",
+	"April":                                                                              "四月",
+	"May":                                                                                "五月",
+	"June":                                                                               "六月",
+	"July":                                                                               "七月",
+	"August":                                                                             "八月",
+	"September":                                                                          "九月",
+	"October":                                                                            "十月",
+	"November":                                                                           "十一月",
+	"December":                                                                           "十二月",
+}
+
+var zhTWDayAbbreviations = []string{"週日", "週一", "週二", "週三", "週四", "週五", "週六"}
+
+var zhTWMonthAbbreviations = []string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+var goldenCronList = []string{
+	"0 1 */4 * * *",
+	"0/2 * * * * ?",
+	"0 0/2 * * * ?",
+	"0 0 2 1 * ?",
+	"0 15 10 ? * MON-FRI",
+	"0 0 10,14,16 * * ?",
+	"0 0/30 9-17 * * ?",
+	"0 0 12 ? * WED",
+	"0 0 12 * * ?",
+	"0 15 10 ? * *",
+	"0 15 10 * * ?",
+	"0 15 10 * * ? 2005",
+	"0 * 14 * * ?",
+	"0 0/5 14 * * ?",
+	"0 0/5 14,18 * * ?",
+	"0 0-5 14 * * ?",
+	"0 10,44 14 ? 3 WED",
+	"0 15 10 15 * ?",
+	"0 15 10 L * ?",
+	"0 15 10 ? * 6L",
+	"0 15 10 ? * 6L 2002-2005",
+	"0 15 10 ? * 6#3",
+	"0 0 12 ? * SUN#1",
+	"0 0 12 ? * 5#2",
+	"0 0 12 ? * 0L",
+	"0 0 12 ? 1-3 MON,WED",
+	"0 0 12 ? * 3-5",
+	"0 0 12 ? * 2-4",
+	"0 0 0 1,15 * ?",
+	"0 0 0 1,10,20 * ?",
+	"0 0 12 1,15-17 * ?",
+	"0 0 0 1-10 * ?",
+	"0 0 0 15W * ?",
+	"0 0 0 2/3 * ?",
+	"0 9-17 * * 1-5",
+	"0 0 * * 0,6",
+	"0 12 * * 5,6",
+	"*/15 * * * *",
+	"*/30 * * * MON-FRI",
+	"0 0 1 1,4,7,10 *",
+	"0 9 1 */3 *",
+	"0 0 1 * *",
+	"0 0 1 1 *",
+	"15,45 10,14 * * *",
+	"0 9-17 * * *",
+	"30 15 9-17 * * ?",
+	"0 6-11,13-18 * * *",
+	"0 8-20/2 * * *",
+	"*/15 8-18/2 * * *",
+	"0-30/10 9 * * *",
+}
+
+var goldenLocaleList = map[string]int{
+	"en_US": locale.EN_US,
+	"de_DE": locale.DE_DE,
+	"fr_FR": locale.FR_FR,
+	"es_ES": locale.ES_ES,
+	"it_IT": locale.IT_IT,
+	"pt_BR": locale.PT_BR,
+	"nl_NL": locale.NL_NL,
+	"ru_RU": locale.RU_RU,
+	"pl_PL": locale.PL_PL,
+	"zh_CN": locale.ZH_CN,
+	"zh_TW": locale.ZH_TW,
+	"ja_JP": locale.JA_JP,
+	"ko_KR": locale.KO_KR,
+	"ar_SA": locale.AR_SA,
+	"he_IL": locale.HE_IL,
+	"fa_IR": locale.FA_IR,
+}
+
+func TestDescriptor_GetDescription_golden(t *testing.T) {
+	for name, language := range goldenLocaleList {
+		opts := NewDefaultOptions()
+		opts.Language = language
+		opts.Use24hourTimeFormat = language != locale.EN_US
+
+		lines := make([]string, 0)
+		for _, cron := range goldenCronList {
+			lines = append(lines, fmt.Sprintf("%s\t%s", cron, NewDescriptor(cron, opts).GetDescription()))
+		}
+		actual := strings.Join(lines, "\n") + "\n"
+
+		goldenFile := filepath.Join("testdata", name+".golden")
+		if *updateGolden {
+			if err := ioutil.WriteFile(goldenFile, []byte(actual), 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+
+		expected, err := ioutil.ReadFile(goldenFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != string(expected) {
+			t.Errorf("%s: description differs from %s\nexpected:\n%s\nactual:\n%s", name, goldenFile, expected, actual)
+		}
+	}
+}
+
+func TestDescriptor_GetDescription_rightToLeft(t *testing.T) {
+	testList := []struct {
+		language     int
+		nativeDigits bool
+		expected     []rune
+	}{
+		{locale.AR_SA, false, []rune("في الساعة \u206610:15\u2069، فقط في عام \u20662005\u2069")},
+		{locale.AR_SA, true, []rune("في الساعة \u2066١٠:١٥\u2069، فقط في عام \u2066٢٠٠٥\u2069")},
+		{locale.FA_IR, true, []rune("در ساعت \u2066۱۰:۱۵\u2069، فقط در سال \u2066۲۰۰۵\u2069")},
+		{locale.HE_IL, true, []rune("ב-\u206610:15\u2069, רק בשנת \u20662005\u2069")},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		opts.Use24hourTimeFormat = true
+		opts.NativeDigits = val.nativeDigits
+
+		d := []rune(NewDescriptor("0 15 10 * * ? 2005", opts).GetDescription())
+		if string(d) != string(val.expected) {
+			t.Errorf("language %d: expected %U, got %U", val.language, val.expected, d)
+		}
+	}
+}
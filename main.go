@@ -1,51 +1,214 @@
 package main
 
 import (
+	"cron-descriptor/locale"
+	"flag"
 	"fmt"
-	"golang.org/x/text/language"
-	"golang.org/x/text/message"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
 	"time"
 )
 
-func init() {
-	message.SetString(language.Chinese, "%s went to %s", "%s去了%s")
-	message.SetString(language.Chinese, "hello! ", "你好！ a")
-	message.SetString(language.Chinese, "hello!", "你好！ b")
-}
+// The command line describes the expression given as its arguments, quoted or not:
+//
+//	cron-descriptor -locale de_DE --explain "*/15 9-17 * * MON-FRI"
+//
+// With --explain a table of the fields follows the description, with --stats the
+// runs over a window of time (i.e. -window 30d -tz Europe/Berlin). The lint command
+// checks expressions instead of describing them (see lintCommand.go) and the compile
+// command writes the expression of a schedule in English (see compile.go):
+//
+//	cron-descriptor compile -dialect quartz "every weekday at 9:30am"
+//
+// The appengine command converts the schedules of a cron.yaml (see appengineCommand.go).
 
 func main() {
-	p := message.NewPrinter(language.Chinese)
-	p.Printf("%s went to %s", "撒", "广州")
-	fmt.Println()
-
-	fmt.Println(time.August.String())
-	p.Printf("hello! ")
-	fmt.Println()
-	fmt.Println(p.Sprintf("hello!"))
-	//a := []string{"a"}
-	//a = append(a, "b")
-	//fmt.Println(a)
-	//s := "zzasadcads"
-	//sList := strings.SplitN(s, "a", 3)
-	//fmt.Println(sList)
-	//fmt.Println(fmt.Sprintf("zxc %s asd", ""))
-
-	//m := &my{}
-	//Get(m)
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run runs the command line and returns its exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) > 0 && args[0] == "lint" {
+		return runLint(args[1:], stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == "compile" {
+		return runCompile(args[1:], stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == "appengine" {
+		return runAppEngine(args[1:], stdout, stderr)
+	}
+
+	flagSet := flag.NewFlagSet("cron-descriptor", flag.ContinueOnError)
+	flagSet.SetOutput(stderr)
+	localeName := flagSet.String("locale", "en_US", "locale of the description, i.e. de_DE")
+	use24hour := flagSet.Bool("24h", false, "use the 24-hour clock")
+	explain := flagSet.Bool("explain", false, "explain the expression field by field")
+	stats := flagSet.Bool("stats", false, "count the runs over a window of time")
+	fromText := flagSet.String("from", "", "start of the window, i.e. 2025-01-01 (default today)")
+	windowText := flagSet.String("window", "365d", "length of the window, in days (30d) or as a duration (12h)")
+	timeZone := flagSet.String("tz", "Local", "time zone of the window, i.e. Europe/Berlin")
+	if err := flagSet.Parse(args); err != nil {
+		return 2
+	}
+	expression := strings.Join(flagSet.Args(), " ")
+	if expression == "" {
+		fmt.Fprintln(stderr, "usage: cron-descriptor [flags] expression")
+		flagSet.PrintDefaults()
+		return 2
+	}
+
+	opts := NewDefaultOptions()
+	localeType, ok := locale.Lookup(*localeName)
+	if !ok {
+		fmt.Fprintf(stderr, "unknown locale %q\n", *localeName)
+		return 2
+	}
+	opts.Language = localeType
+	opts.Use24hourTimeFormat = *use24hour
+
+	var from time.Time
+	var window time.Duration
+	if *stats {
+		location, err := time.LoadLocation(*timeZone)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+		if from, err = parseFrom(*fromText, location); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+		if window, err = parseWindow(*windowText); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+	}
+
+	desc := NewDescriptor(expression, opts)
+	segments, err := desc.GetSegments()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	fmt.Fprintln(stdout, joinSegments(segments, ""))
+
+	if *explain {
+		rowList, err := desc.Explain()
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		fmt.Fprintln(stdout)
+		writeExplanation(stdout, rowList)
+	}
+	if *stats {
+		result, err := desc.Stats(from, window)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		fmt.Fprintln(stdout)
+		writeStats(stdout, result)
+	}
+	return 0
+}
+
+// runCompile runs the compile command and returns its exit code
+func runCompile(args []string, stdout, stderr io.Writer) int {
+	flagSet := flag.NewFlagSet("cron-descriptor compile", flag.ContinueOnError)
+	flagSet.SetOutput(stderr)
+	dialectName := flagSet.String("dialect", "and", "cron dialect: and, vixie or quartz")
+	if err := flagSet.Parse(args); err != nil {
+		return 2
+	}
+	dialect, ok := dialectNameList[*dialectName]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown dialect %q\n", *dialectName)
+		return 2
+	}
+	text := strings.Join(flagSet.Args(), " ")
+	if text == "" {
+		fmt.Fprintln(stderr, "usage: cron-descriptor compile [flags] schedule")
+		flagSet.PrintDefaults()
+		return 2
+	}
 
+	expression, err := CompileSchedule(text, dialect)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	fmt.Fprintln(stdout, expression)
+	return 0
 }
 
-func Get(m myinter) {
-	fmt.Println("name:", m.Getname())
+// parseFrom parses the start of the window, a date or a time in RFC 3339, today when empty
+func parseFrom(text string, location *time.Location) (time.Time, error) {
+	if text == "" {
+		now := time.Now().In(location)
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location), nil
+	}
+	if from, err := time.ParseInLocation("2006-01-02", text, location); err == nil {
+		return from, nil
+	}
+	from, err := time.Parse(time.RFC3339, text)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid start of the window %q", text)
+	}
+	return from.In(location), nil
 }
 
-type myinter interface {
-	Getname() string
+// parseWindow parses the length of the window, a number of days (i.e. 30d) or a duration
+func parseWindow(text string) (time.Duration, error) {
+	if strings.HasSuffix(text, "d") {
+		if days, err := strconv.Atoi(strings.TrimSuffix(text, "d")); err == nil && days > 0 {
+			return time.Duration(days) * 24 * time.Hour, nil
+		}
+	} else if window, err := time.ParseDuration(text); err == nil && window > 0 {
+		return window, nil
+	}
+	return 0, fmt.Errorf("invalid window %q", text)
 }
 
-type my struct {
+// writeExplanation writes the rows of an explanation as a table
+func writeExplanation(w io.Writer, rowList []FieldExplanation) {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "TOKEN\tFIELD\tALLOWED\tEXPLANATION")
+	for _, row := range rowList {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\n", row.Token, row.Name, row.Range, row.Explanation)
+	}
+	table.Flush()
 }
 
-func (self *my) Getname() string {
-	return "your name"
+// writeStats writes the statistics of an expression as a table
+func writeStats(w io.Writer, result *Stats) {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(table, "RUNS\t%d\n", result.Count)
+	for _, period := range []struct {
+		name  string
+		count PeriodCount
+	}{
+		{"PER DAY", result.PerDay},
+		{"PER WEEK", result.PerWeek},
+		{"PER MONTH", result.PerMonth},
+	} {
+		if period.count.Periods == 0 {
+			continue
+		}
+		countRange := strconv.FormatInt(period.count.Min, 10)
+		if period.count.Max != period.count.Min {
+			countRange += "-" + strconv.FormatInt(period.count.Max, 10)
+		}
+		fmt.Fprintf(table, "%s\t%s, mean %.2f over %d\n", period.name, countRange, period.count.Mean, period.count.Periods)
+	}
+	if result.Count > 1 {
+		fmt.Fprintf(table, "INTERVAL\t%s-%s, mean %s\n", result.MinInterval, result.MaxInterval, result.MeanInterval.Round(time.Second))
+	}
+	if result.BusiestHour >= 0 {
+		fmt.Fprintf(table, "BUSIEST HOUR\t%02d:00, %d runs\n", result.BusiestHour, result.BusiestHourCount)
+	}
+	table.Flush()
 }
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	testList := []struct {
+		args     []string
+		code     int
+		expected string
+	}{
+		{[]string{"*/5", "*", "*", "*", "*"}, 0, "Every 5 minutes\n"},
+		{[]string{"-locale", "fr_FR", "0 12 * * *"}, 0, "À midi\n"},
+		{[]string{"--explain", "30 4 * * *"}, 0, "At 04:30 AM\n\n" +
+			"TOKEN  FIELD         ALLOWED        EXPLANATION\n" +
+			"30     minute        0-59           at 30 minutes past the hour\n" +
+			"4      hour          0-23           at 04:00 AM\n" +
+			"*      day of month  1-31           on any day of the month\n" +
+			"*      month         1-12, JAN-DEC  every month\n" +
+			"*      day of week   0-6, SUN-SAT   on any day of the week\n"},
+		{[]string{"-stats", "-from", "2024-01-01", "-window", "7d", "-tz", "UTC", "0 9 * * 1-5"}, 0, "At 09:00 AM on weekdays\n\n" +
+			"RUNS          5\n" +
+			"PER DAY       0-1, mean 0.71 over 7\n" +
+			"PER WEEK      5, mean 5.00 over 1\n" +
+			"INTERVAL      24h0m0s-24h0m0s, mean 24h0m0s\n" +
+			"BUSIEST HOUR  09:00, 5 runs\n"},
+		{[]string{"-stats", "-window", "soon", "* * * * *"}, 2, ""},
+		{[]string{"compile", "every", "weekday", "at", "9:30am"}, 0, "30 9 * * MON-FRI\n"},
+		{[]string{"compile", "-dialect", "quartz", "last Friday of the month at 18:00"}, 0, "0 0 18 ? * FRIL\n"},
+		{[]string{"compile", "-dialect", "vixie", "last Friday of the month at 18:00"}, 1, ""},
+		{[]string{"* * *"}, 1, ""},
+		{[]string{"-locale", "xx", "* * * * *"}, 2, ""},
+		{[]string{}, 2, ""},
+	}
+
+	for _, val := range testList {
+		var stdout, stderr bytes.Buffer
+		if code := run(val.args, &stdout, &stderr); code != val.code {
+			t.Errorf("%v: expected exit code %d, got %d (%s)", val.args, val.code, code, stderr.String())
+		}
+		if stdout.String() != val.expected {
+			t.Errorf("%v: expected %q, got %q", val.args, val.expected, stdout.String())
+		}
+	}
+}
+
+func TestRun_lint(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "crontab")
+	if err := ioutil.WriteFile(fileName, []byte("*/7 * * * *\n  0 24 * * *\n0 0 29 2 * # lint-ignore: rarely-fires\n0 0 ? * 5#3\n0 0 ? * 5#6 # last\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	testList := []struct {
+		args     []string
+		code     int
+		expected string
+	}{
+		{[]string{"lint", "*/15", "*", "*", "*", "*"}, 0, ""},
+		{[]string{"lint", "-suppress", "uneven-step", "*/7 * * * *"}, 0, ""},
+		{[]string{"lint", "*/7 * * * *"}, 0, "warning: The step */7 does not divide 60 evenly, one gap is only 4 [uneven-step]\n"},
+		{[]string{"lint", "-file", fileName}, 1, fileName + ":1:1: warning: The step */7 does not divide 60 evenly, one gap is only 4 [uneven-step]\n" +
+			fileName + ":2:5: error: Hours go from 0 to 23, midnight is 0 [hour-24]\n" +
+			"\tfix: 0 0 * * *\n" +
+			fileName + ":5:9: error: The day of week '5#6' is not valid [invalid-field]\n"},
+		{[]string{"lint", "-format", "json", "0 0 30 2 *"}, 1, `[
+  {
+    "expression": "0 0 30 2 *",
+    "diagnostics": [
+      {
+        "rule": "never-fires",
+        "severity": "error",
+        "start": 0,
+        "end": 10,
+        "message": "The expression never fires"
+      }
+    ]
+  }
+]
+`},
+		{[]string{"lint", "-suppress", "no-such-rule", "* * * * *"}, 2, ""},
+		{[]string{"lint", "-format", "xml", "* * * * *"}, 2, ""},
+		{[]string{"lint"}, 2, ""},
+	}
+
+	for _, val := range testList {
+		var stdout, stderr bytes.Buffer
+		if code := run(val.args, &stdout, &stderr); code != val.code {
+			t.Errorf("%v: expected exit code %d, got %d (%s)", val.args, val.code, code, stderr.String())
+		}
+		if stdout.String() != val.expected {
+			t.Errorf("%v: expected %q, got %q", val.args, val.expected, stdout.String())
+		}
+	}
+}
+
+func TestRun_appengine(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "cron.yaml")
+	cronYAML := "cron:\n" +
+		"- description: \"nightly\"\n" +
+		"  url: /tasks/nightly\n" +
+		"  schedule: every day 00:00\n" +
+		"- url: /tasks/late\n" +
+		"  schedule: \"every 30 mins from 22:00 to 02:00\"\n" +
+		"  # schedule: every monday 09:00\n"
+	if err := ioutil.WriteFile(fileName, []byte(cronYAML), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	testList := []struct {
+		args     []string
+		code     int
+		expected string
+	}{
+		{[]string{"appengine", "every", "monday", "09:00"}, 0, "every monday 09:00\n\t0 9 * * MON\tAt 09:00 AM, only on Monday\n"},
+		{[]string{"appengine", "-locale", "de_DE", "every 5 minutes"}, 0, "every 5 minutes\n\t*/5 * * * *\tAlle 5 Minuten (ungefähr)\n"},
+		{[]string{"appengine", "-dialect", "vixie", "1st monday of sep 09:00"}, 1,
+			"1st monday of sep 09:00\n\tcannot be converted: unix cron has no seconds, years, L, W or #\n"},
+		{[]string{"appengine", "-file", fileName}, 1, fileName + ":4: every day 00:00\n\t0 0 * * *\tAt midnight\n" +
+			fileName + ":6: every 30 mins from 22:00 to 02:00\n\tcannot be converted: the interval runs past midnight\n"},
+		{[]string{"appengine", "every foo"}, 1, "every foo\n\tinvalid schedule: invalid time \"foo\"\n"},
+		{[]string{"appengine", "-dialect", "cobol", "every day 00:00"}, 2, ""},
+		{[]string{"appengine"}, 2, ""},
+	}
+
+	for _, val := range testList {
+		var stdout, stderr bytes.Buffer
+		if code := run(val.args, &stdout, &stderr); code != val.code {
+			t.Errorf("%v: expected exit code %d, got %d (%s)", val.args, val.code, code, stderr.String())
+		}
+		if stdout.String() != val.expected {
+			t.Errorf("%v: expected %q, got %q", val.args, val.expected, stdout.String())
+		}
+	}
+}
+
+func TestRun_lintSARIF(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "crontab")
+	if err := ioutil.WriteFile(fileName, []byte("# hourly\n  0 24 * * *\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"lint", "-format", "sarif", "-file", fileName}, &stdout, &stderr); code != 1 {
+		t.Fatalf("expected exit code 1, got %d (%s)", code, stderr.String())
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(stdout.Bytes(), &log); err != nil {
+		t.Fatal(err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 || len(log.Runs[0].Tool.Driver.Rules) != len(LintRules()) {
+		t.Fatalf("unexpected log %+v", log)
+	}
+	resultList := log.Runs[0].Results
+	if len(resultList) != 1 {
+		t.Fatalf("expected a single result, got %+v", resultList)
+	}
+	result := resultList[0]
+	if result.RuleID != RuleHour24 || result.Level != "error" || log.Runs[0].Tool.Driver.Rules[result.RuleIndex].ID != RuleHour24 {
+		t.Errorf("unexpected result %+v", result)
+	}
+	region := result.Locations[0].PhysicalLocation.Region
+	if region != (sarifRegion{StartLine: 2, StartColumn: 5, EndColumn: 7}) {
+		t.Errorf("unexpected region %+v", region)
+	}
+	replacement := result.Fixes[0].ArtifactChanges[0].Replacements[0]
+	if replacement.DeletedRegion != (sarifRegion{StartLine: 2, StartColumn: 3, EndColumn: 13}) || replacement.InsertedContent.Text != "0 0 * * *" {
+		t.Errorf("unexpected fix %+v", replacement)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"strconv"
+
+	"golang.org/x/text/feature/plural"
+)
+
+// Counts and ordinals below the SpellOutBelow option are written as words
+// (i.e. "every two hours", "on the first Monday"), all of them with the Speakable
+// option. Times of day, minutes past the hour and years are only spelled out
+// by speakable descriptions (see speech.go).
+
+// countf formats a message counting something, i.e. "every %s hours". The variant of the
+// message for the plural form of the count is used when the locale has one
+// (i.e. "каждые 2 часа", "каждые 5 часов"), and the count agrees with what it counts.
+func (self *descriptor) countf(key string, count string) string {
+	language := self.Options.Language
+	number, err := strconv.Atoi(count)
+	if err != nil {
+		return self.Printer.Sprintf(key, count)
+	}
+
+	gender := locale.CountGender(language, key)
+	if self.spellsOut(number) {
+		if word, ok := locale.NumberWord(language, number, gender); ok {
+			count = word
+		}
+	}
+	return self.Printer.Sprintf(self.pluralKey(key, number), count)
+}
+
+// pluralKey returns the key of the variant of the message for the plural form of number,
+// or the key itself when the locale has none
+func (self *descriptor) pluralKey(key string, number int) string {
+	language := self.Options.Language
+	form := plural.Cardinal.MatchPlural(locale.Tag(language), number, 0, 0, 0, 0)
+	if pluralKey := locale.PluralKey(key, form); locale.HasMessage(language, pluralKey) {
+		return pluralKey
+	}
+	return key
+}
+
+// ordinal returns the ordinal of the nth weekday of the month agreeing with the gender of the day
+func (self *descriptor) ordinal(number int, gender locale.Gender) string {
+	if self.spellsOut(number) {
+		if word, ok := locale.OrdinalWord(self.Options.Language, number, gender); ok {
+			return word
+		}
+	}
+	return locale.Ordinal(self.Options.Language, number, gender)
+}
+
+// dayOrdinal returns the ordinal of a day of the month
+func (self *descriptor) dayOrdinal(number int) string {
+	if self.spellsOut(number) {
+		if word, ok := locale.DayOrdinalWord(self.Options.Language, number); ok {
+			return word
+		}
+	}
+	return locale.DayOrdinal(self.Options.Language, number)
+}
+
+func (self *descriptor) spellsOut(number int) bool {
+	return !self.isShortStyle() && (self.Options.Speakable || number < self.Options.SpellOutBelow)
+}
@@ -1,23 +1,47 @@
 package main
 
-import "cron-descriptor/locale"
+import (
+	"cron-descriptor/locale"
+	"time"
+)
 
 type options struct {
 	DescriptionType         int
+	DescriptionStyle        int
+	OutputFormat            int
 	CasingType              int
-	Verbose                 bool
+	Verbosity               int
+	Dialect                 int
 	DayOfWeekStartIndexZero bool
 	Use24hourTimeFormat     bool
 	Language                int
+	NativeDigits            bool
+	SpellOutBelow           int
+	Speakable               bool
+	Summarize               bool
+	ReferenceTime           time.Time
+	TimeZone                *time.Location
+	ExampleCount            int
+	SuppressedRules         []string
+	FiringWarning           bool
 }
 
 func NewDefaultOptions() *options {
 	return &options{
 		DescriptionType:         DescFull,
+		DescriptionStyle:        StyleLong,
+		OutputFormat:            FormatText,
 		CasingType:              CasingSentence,
-		Verbose:                 false,
+		Verbosity:               VerbosityNormal,
+		Dialect:                 unuseDialect,
 		DayOfWeekStartIndexZero: true,
 		Use24hourTimeFormat:     false,
 		Language:                locale.EN_US,
+		NativeDigits:            false,
+		SpellOutBelow:           0,
+		Speakable:               false,
+		Summarize:               true,
+		ExampleCount:            0,
+		FiringWarning:           false,
 	}
 }
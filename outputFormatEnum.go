@@ -0,0 +1,11 @@
+package main
+
+const (
+	//OutputFormatEnum
+	unuseFormat = iota
+	FormatText
+	FormatHTML
+	FormatMarkdown
+	FormatANSI
+	FormatSSML
+)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -37,13 +38,26 @@ var (
 )
 
 func parse(desc *descriptor) (*cronEntity, error) {
-	entity := &cronEntity{}
 	if desc.Expression == "" {
 		return nil, errors.New("expression is empty")
 	}
 
-	expressionPartsTemp := strings.Split(desc.Expression, " ")
+	entity, err := splitFields(strings.Split(desc.Expression, " "))
+	if err != nil {
+		return nil, err
+	}
+	entity = normalizeExpression(entity, desc.Options)
+	if err := checkDialect(entity, desc.Options); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// splitFields assigns the parts of an expression to the fields and remembers where each of them is
+func splitFields(expressionPartsTemp []string) (*cronEntity, error) {
+	entity := &cronEntity{}
 	expressionPartsTempLength := len(expressionPartsTemp)
+	firstField := FieldMinutes
 	if expressionPartsTempLength < 5 {
 		return nil, errors.New("expression part less than 5")
 
@@ -72,6 +86,7 @@ func parse(desc *descriptor) (*cronEntity, error) {
 			}
 
 		} else {
+			firstField = FieldSeconds
 			entity = &cronEntity{
 				Seconds:    expressionPartsTemp[0],
 				Minutes:    expressionPartsTemp[1],
@@ -83,6 +98,7 @@ func parse(desc *descriptor) (*cronEntity, error) {
 		}
 
 	} else if expressionPartsTempLength == 7 {
+		firstField = FieldSeconds
 		entity = &cronEntity{
 			Seconds:    expressionPartsTemp[0],
 			Minutes:    expressionPartsTemp[1],
@@ -97,7 +113,14 @@ func parse(desc *descriptor) (*cronEntity, error) {
 		return nil, errors.New("expression part more than 7")
 	}
 
-	return normalizeExpression(entity, desc.Options), nil
+	//remember where each field is in the expression, before normalization rewrites it
+	entity.Spans = make(map[int]Span)
+	start := 0
+	for i, part := range expressionPartsTemp {
+		entity.Spans[firstField+i] = Span{Start: start, End: start + len(part)}
+		start += len(part) + 1
+	}
+	return entity, nil
 }
 
 func normalizeExpression(entity *cronEntity, opts *options) *cronEntity {
@@ -198,9 +221,10 @@ func normalizeExpression(entity *cronEntity, opts *options) *cronEntity {
 
 func decreaseDaysOfWeek(dayOfWeekExpressionPart string) string {
 	dowChars := make([]string, 0)
-	for i, dowCharACSII := range dayOfWeekExpressionPart {
+	for _, dowCharACSII := range dayOfWeekExpressionPart {
 		char := string(dowCharACSII)
-		dowChars[i] = char
+		i := len(dowChars)
+		dowChars = append(dowChars, char)
 		if i == 0 || dowChars[i-1] != "#" && dowChars[i-1] != "/" {
 			if num, err := strconv.Atoi(char); err == nil {
 				dowChars[i] = strconv.Itoa(num - 1)
@@ -209,3 +233,56 @@ func decreaseDaysOfWeek(dayOfWeekExpressionPart string) string {
 	}
 	return strings.Join(dowChars, "")
 }
+
+// expandField lists in order the values a field of the normalized expression matches,
+// ok is false when the field uses anything but numbers, ranges, steps and lists.
+// An empty field, such as omitted seconds, matches min only.
+func expandField(expression string, min, max int) ([]int, bool) {
+	if expression == "" {
+		return []int{min}, true
+	}
+
+	valueSet := make(map[int]bool)
+	for _, item := range strings.Split(expression, ",") {
+		rangeExp := item
+		step := 1
+		stepParts := strings.SplitN(item, "/", 2)
+		if len(stepParts) == 2 {
+			rangeExp = stepParts[0]
+			var err error
+			if step, err = strconv.Atoi(stepParts[1]); err != nil || step < 1 {
+				return nil, false
+			}
+		}
+
+		first, last := min, max
+		if rangeExp != "*" {
+			bounds := strings.SplitN(rangeExp, "-", 2)
+			var err error
+			if first, err = strconv.Atoi(bounds[0]); err != nil {
+				return nil, false
+			}
+			if len(bounds) == 2 {
+				if last, err = strconv.Atoi(bounds[1]); err != nil {
+					return nil, false
+				}
+			} else if len(stepParts) == 1 {
+				last = first
+			}
+		}
+		if first < min || last > max || first > last {
+			return nil, false
+		}
+
+		for value := first; value <= last; value += step {
+			valueSet[value] = true
+		}
+	}
+
+	valueList := make([]int, 0)
+	for value := range valueSet {
+		valueList = append(valueList, value)
+	}
+	sort.Ints(valueList)
+	return valueList, true
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A partial description recovers from the fields that do not parse, which is what
+// an expression being typed has most of the time: the other fields are described
+// as usual and a placeholder such as "[invalid day of week 'MOX']" stands for each
+// field that does not parse or is still missing. The errors come alongside.
+// Partial descriptions are long, without summaries or examples, since those need
+// every field.
+
+// FieldError is a field of the expression that does not parse, Token is empty when
+// the field is missing
+type FieldError struct {
+	Field int
+	Token string
+	Span  Span
+}
+
+func (self *FieldError) Error() string {
+	if self.Token == "" {
+		return fmt.Sprintf("missing %s", FieldName(self.Field))
+	}
+	return fmt.Sprintf("invalid %s %q", FieldName(self.Field), self.Token)
+}
+
+// GetPartialDescription describes what it can of the expression, see GetPartialSegments
+func (self *descriptor) GetPartialDescription() (string, []error) {
+	segments, errList := self.GetPartialSegments()
+	if segments == nil {
+		return self.renderError(errList[0]), errList
+	}
+	return self.render(segments), errList
+}
+
+// GetPartialSegments describes the fields that parse and puts a segment of the
+// SegmentInvalid kind where a field does not. The errors are FieldErrors but when
+// the expression cannot be split into fields at all, then there are no segments.
+func (self *descriptor) GetPartialSegments() ([]Segment, []error) {
+	entity, errList, err := parsePartial(self)
+	if err != nil {
+		return nil, []error{err}
+	}
+	if len(errList) == 0 {
+		segments, err := self.describe(entity)
+		if err != nil {
+			return nil, []error{err}
+		}
+		return segments, nil
+	}
+
+	opts := *self.Options
+	opts.DescriptionStyle = StyleLong
+	opts.Summarize = false
+	opts.ExampleCount = 0
+	opts.FiringWarning = false
+	partial := &descriptor{Expression: self.Expression, Printer: self.Printer, Options: &opts}
+	segments, err := partial.describe(entity)
+	if err != nil {
+		return nil, append(errList, err)
+	}
+	return segments, errList
+}
+
+// parsePartial parses the expression as far as it can. The fields that do not parse are
+// kept in the Invalid fields of the entity and set to what describes nothing (i.e. *),
+// the missing fields of an expression too short are added that way.
+func parsePartial(desc *descriptor) (*cronEntity, []error, error) {
+	if desc.Expression == "" {
+		return nil, nil, errors.New("expression is empty")
+	}
+
+	partList := strings.Split(desc.Expression, " ")
+	for len(partList) < 5 {
+		partList = append(partList, "*")
+	}
+	entity, err := splitFields(partList)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entity.Invalid = make(map[int]string)
+	for field := FieldSeconds; field <= FieldYear; field++ {
+		span, ok := entity.Spans[field]
+		if !ok {
+			continue
+		}
+		if span.Start > len(desc.Expression) {
+			//added after the end of the expression
+			entity.Spans[field] = Span{Start: len(desc.Expression), End: len(desc.Expression)}
+			entity.Invalid[field] = ""
+		} else if span.Start == span.End {
+			entity.Invalid[field] = ""
+		}
+	}
+
+	entity = normalizeExpression(entity, desc.Options)
+	for field := FieldSeconds; field <= FieldYear; field++ {
+		span, ok := entity.Spans[field]
+		if !ok {
+			continue
+		}
+		if !entity.isInvalid(field) && !fieldValid(entity, field) {
+			entity.Invalid[field] = desc.Expression[span.Start:span.End]
+		}
+	}
+	if !entity.isInvalid(FieldDayOfMonth) && !entity.isInvalid(FieldDayOfWeek) && checkDialect(entity, desc.Options) != nil {
+		span := entity.Spans[FieldDayOfWeek]
+		entity.Invalid[FieldDayOfWeek] = desc.Expression[span.Start:span.End]
+	}
+
+	errList := make([]error, 0)
+	for field := FieldSeconds; field <= FieldYear; field++ {
+		token, ok := entity.Invalid[field]
+		if !ok {
+			continue
+		}
+		errList = append(errList, &FieldError{Field: field, Token: token, Span: entity.Spans[field]})
+		switch field {
+		case FieldSeconds:
+			entity.Seconds = ""
+		case FieldMinutes:
+			entity.Minutes = "*"
+		case FieldHours:
+			entity.Hours = "*"
+		case FieldDayOfMonth:
+			entity.DayOfMonth = "*"
+		case FieldMonth:
+			entity.Month = "*"
+		case FieldDayOfWeek:
+			entity.DayOfWeek = "*"
+		case FieldYear:
+			entity.Year = ""
+		}
+	}
+	return entity, errList, nil
+}
+
+// fieldValid reports whether a field of the normalized expression parses
+func fieldValid(entity *cronEntity, field int) bool {
+	var ok bool
+	switch field {
+	case FieldSeconds:
+		_, ok = expandField(entity.Seconds, 0, 59)
+	case FieldMinutes:
+		_, ok = expandField(entity.Minutes, 0, 59)
+	case FieldHours:
+		_, ok = expandField(entity.Hours, 0, 23)
+	case FieldMonth:
+		_, ok = expandField(entity.Month, 1, 12)
+	case FieldYear:
+		_, ok = expandField(entity.Year, 1970, lastYear)
+	case FieldDayOfMonth:
+		return dayFieldValid(entity.DayOfMonth, dayOfMonthMatcher)
+	case FieldDayOfWeek:
+		return dayFieldValid(entity.DayOfWeek, dayOfWeekMatcher)
+	}
+	return ok
+}
+
+// dayFieldValid reports whether every item of a day field has a matcher (see schedule.go)
+func dayFieldValid(expression string, fnMatcher func(item string) (func(date time.Time) bool, error)) bool {
+	if expression == "*" {
+		return true
+	}
+	for _, item := range strings.Split(expression, ",") {
+		if _, err := fnMatcher(item); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (self *cronEntity) isInvalid(field int) bool {
+	_, ok := self.Invalid[field]
+	return ok
+}
+
+// fieldSegment returns the segment of a field, the placeholder when the field does not parse
+func (self *descriptor) fieldSegment(entity *cronEntity, field int, fnDescription func(entity *cronEntity) string) Segment {
+	token, ok := entity.Invalid[field]
+	if !ok {
+		return newFieldSegment(entity, field, fnDescription(entity))
+	}
+
+	name := self.Printer.Sprintf(fieldLabelList[field])
+	text := self.Printer.Sprintf("[missing %s]", name)
+	if token != "" {
+		text = self.Printer.Sprintf("[invalid %s '%s']", name, token)
+	}
+	//the day, month and year describers start a clause, the time of day ones are joined later
+	if field >= FieldDayOfMonth {
+		text = locale.ClauseSeparator(self.Options.Language) + text
+	}
+	return newSegment(entity, SegmentInvalid, text, field)
+}
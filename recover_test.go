@@ -0,0 +1,67 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"errors"
+	"testing"
+)
+
+func TestDescriptor_GetPartialDescription(t *testing.T) {
+	testList := []struct {
+		language int
+		cron     string
+		expected string
+		errors   []string
+	}{
+		{locale.EN_US, "0 9 * * 1-5", "At 09:00 AM on weekdays", nil},
+		{locale.EN_US, "0 9 * * MOX", "At 09:00 AM, [invalid day of week 'MOX']", []string{`invalid dayOfWeek "MOX"`}},
+		{locale.EN_US, "6x 10 * * *", "[Invalid minute '6x'], at 10:00 AM", []string{`invalid minutes "6x"`}},
+		{locale.EN_US, "1x 0 12 L * ?", "[Invalid second '1x'], at 12:00 PM, on the last day of the month",
+			[]string{`invalid seconds "1x"`}},
+		{locale.EN_US, "0 9 32 13 *", "At 09:00 AM, [invalid day of month '32'], [invalid month '13']",
+			[]string{`invalid dayOfMonth "32"`, `invalid month "13"`}},
+		{locale.EN_US, "0 0 12 ? * MON 19x9", "At 12:00 PM, only on Monday, [invalid year '19x9']", []string{`invalid year "19x9"`}},
+		{locale.EN_US, "*/5 * ", "Every 5 minutes, [missing day of month], [missing day of week], [missing month]",
+			[]string{"missing dayOfMonth", "missing month", "missing dayOfWeek"}},
+		{locale.DE_DE, "0 9 * * MOX", "Um 09:00 AM, [Wochentag ungültig: „MOX“]", []string{`invalid dayOfWeek "MOX"`}},
+		{locale.JA_JP, "*/5 * * *", "5分ごと、[曜日がありません]", []string{"missing dayOfWeek"}},
+		{locale.EN_US, "1 2 3 4 5 6 7 8", "expression part more than 7", []string{"expression part more than 7"}},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		desc, errList := NewDescriptor(val.cron, opts).GetPartialDescription()
+		if desc != val.expected {
+			t.Errorf("%s: expected %q, got %q", val.cron, val.expected, desc)
+		}
+		if len(errList) != len(val.errors) {
+			t.Errorf("%s: expected errors %v, got %v", val.cron, val.errors, errList)
+			continue
+		}
+		for i, err := range errList {
+			if err.Error() != val.errors[i] {
+				t.Errorf("%s: expected errors %v, got %v", val.cron, val.errors, errList)
+				break
+			}
+		}
+	}
+}
+
+func TestDescriptor_GetPartialSegments(t *testing.T) {
+	opts := NewDefaultOptions()
+	opts.Dialect = DialectQuartz
+	segments, errList := NewDescriptor("0 9 1 * MON", opts).GetPartialSegments()
+	if len(errList) != 1 {
+		t.Fatalf("expected an error, got %v", errList)
+	}
+
+	var fieldErr *FieldError
+	if !errors.As(errList[0], &fieldErr) || fieldErr.Field != FieldDayOfWeek || fieldErr.Span != (Span{8, 11}) {
+		t.Errorf("expected the day of week to be invalid, got %v", errList[0])
+	}
+	last := segments[len(segments)-1]
+	if last.Kind != SegmentInvalid || last.Span != (Span{8, 11}) {
+		t.Errorf("expected a placeholder for the day of week, got %+v", last)
+	}
+}
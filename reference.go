@@ -0,0 +1,95 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// With the ReferenceTime option the years of an expression are described
+// relative to the year of the reference, i.e. "(expired — last ran in 2005)"
+// for an expression whose runs are all over, "starting in 2027, until 2030"
+// for one whose years are all to come.
+
+// lastYear is the open end the parser gives year steps without a range (i.e. 2027/2)
+const lastYear = 9999
+
+// IsExpired reports whether every occurrence of the expression is before ref.
+// Expressions without years never expire.
+func (self *descriptor) IsExpired(ref time.Time) (bool, error) {
+	entity, err := parse(self)
+	if err != nil {
+		return false, err
+	}
+	return self.expiredAt(entity, ref), nil
+}
+
+// expiredAt reports whether the years of the expression are bounded, the last of them
+// has come and the expression does not fire again after ref. An expression that never
+// fires in years still to come has not expired.
+func (self *descriptor) expiredAt(entity *cronEntity, ref time.Time) bool {
+	yearList, ok := yearsOf(entity)
+	if !ok || yearList[len(yearList)-1] > ref.Year() {
+		return false
+	}
+	schedule, err := newSchedule(entity, self.Options)
+	if err != nil {
+		//the days cannot be worked out, only the years
+		return yearList[len(yearList)-1] < ref.Year()
+	}
+	_, ok = schedule.next(ref)
+	return !ok
+}
+
+// yearsOf lists in order the years the expression runs in, ok is false when it runs
+// every year or its years cannot be listed
+func yearsOf(entity *cronEntity) ([]int, bool) {
+	if entity.Year == "" || entity.Year == "*" {
+		return nil, false
+	}
+	yearList, ok := expandField(entity.Year, 1970, lastYear)
+	if !ok || len(yearList) == 0 {
+		return nil, false
+	}
+	return yearList, true
+}
+
+// getRelativeYearDescription describes the years relative to the year of the reference time.
+// The literal description is kept unless the relative one says more.
+func (self *descriptor) getRelativeYearDescription(entity *cronEntity, description string) string {
+	yearList, ok := yearsOf(entity)
+	if !ok {
+		return description
+	}
+	ref := self.Options.ReferenceTime
+	if self.Options.TimeZone != nil {
+		ref = ref.In(self.Options.TimeZone)
+	}
+	refYear := ref.Year()
+	first, last := yearList[0], yearList[len(yearList)-1]
+
+	if self.expiredAt(entity, ref) {
+		return description + self.Printer.Sprintf(" (expired — last ran in %s)", self.ssmlMark(ssmlYear, strconv.Itoa(last)))
+
+	} else if first == refYear && last == refYear {
+		return self.Printer.Sprintf(", this year only")
+
+	} else if first == refYear+1 && last == refYear+1 {
+		return self.Printer.Sprintf(", next year only")
+
+	} else if first > refYear && !strings.Contains(entity.Year, ",") && last > first {
+		//a range or a step, lists keep their years
+		starting := ""
+		if strings.HasSuffix(strings.SplitN(entity.Year, "/", 2)[0], "-"+strconv.Itoa(lastYear)) {
+			//open ended, the end the parser gave the steps is not worth mentioning
+			starting = self.Printer.Sprintf(", starting in %s", self.ssmlMark(ssmlYear, strconv.Itoa(first)))
+		} else {
+			starting = self.Printer.Sprintf(", starting in %s, until %s", self.ssmlMark(ssmlYear, strconv.Itoa(first)), self.ssmlMark(ssmlYear, strconv.Itoa(last)))
+		}
+		if parts := strings.SplitN(entity.Year, "/", 2); len(parts) == 2 {
+			return self.countf(", every %s years", parts[1]) + starting
+		}
+		return starting
+	}
+	return description
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// The renderers mark the part of the description each field of the expression
+// produced, and the expression itself the same way, so that a field and its
+// description can be matched up (i.e. the same color in a terminal). SSML marks
+// what a speech engine should read as a time or a date instead, where the
+// description writes them: they are marked with private use runes like the name
+// references, whatever the digits or words of the locale, and handed to the
+// engine in digits even in speakable descriptions.
+
+// kinds of SSML marks
+const (
+	ssmlTime12   = "t"
+	ssmlTime24   = "T"
+	ssmlYear     = "y"
+	ssmlMonthDay = "d"
+	ssmlDate     = "D"
+)
+
+var (
+	fieldNameList = map[int]string{
+		FieldSeconds:    "seconds",
+		FieldMinutes:    "minutes",
+		FieldHours:      "hours",
+		FieldDayOfMonth: "dayOfMonth",
+		FieldMonth:      "month",
+		FieldDayOfWeek:  "dayOfWeek",
+		FieldYear:       "year",
+	}
+
+	//SGR color of each field in a terminal
+	fieldColorList = map[int]string{
+		FieldSeconds:    "35",
+		FieldMinutes:    "32",
+		FieldHours:      "33",
+		FieldDayOfMonth: "34",
+		FieldMonth:      "36",
+		FieldDayOfWeek:  "31",
+		FieldYear:       "95",
+	}
+
+	//the clause separators (see locale.ClauseSeparator) and spaces a segment starts with
+	//stay outside its markup
+	segmentPrefixRegexp = regexp.MustCompile(`^[,，、،\p{Zs}]*`)
+	segmentSuffixRegexp = regexp.MustCompile(`[\p{Zs}]*$`)
+
+	//the times and dates marked while describing for SSML (see ssmlMark), the kind of a
+	//mark is a letter the digit transforms leave alone
+	ssmlMarkRegexp = regexp.MustCompile("\uE003([a-zA-Z])\uE004([^\uE005]*)\uE005")
+
+	ssmlSayAsList = map[string]string{
+		ssmlTime12:   `interpret-as="time" format="hms12"`,
+		ssmlTime24:   `interpret-as="time" format="hms24"`,
+		ssmlYear:     `interpret-as="date" format="y"`,
+		ssmlMonthDay: `interpret-as="date" format="md"`,
+		ssmlDate:     `interpret-as="date" format="ymd"`,
+	}
+
+	markdownEscaper = strings.NewReplacer(
+		`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `<`, `\<`, `>`, `\>`)
+)
+
+// FieldName returns the name of a field of the expression, i.e. "minutes"
+func FieldName(field int) string {
+	return fieldNameList[field]
+}
+
+// render joins the segments in the output format of the options
+func (self *descriptor) render(segments []Segment) string {
+	textList := make([]string, 0)
+	for _, segment := range segments {
+		if self.Options.OutputFormat == FormatSSML {
+			//SSML marks parts of the text, not the fields
+			textList = append(textList, self.markPart(segment.Text, segment.Fields))
+		} else {
+			textList = append(textList, self.renderPart(segment.Text, segment.Fields))
+		}
+	}
+	return self.wrapDocument(strings.Join(textList, ""))
+}
+
+// renderError formats an error in the output format of the options
+func (self *descriptor) renderError(err error) string {
+	return self.wrapDocument(self.renderPart(err.Error(), nil))
+}
+
+// wrapDocument wraps the rendered text in the root element of the formats that have one
+func (self *descriptor) wrapDocument(text string) string {
+	if self.Options.OutputFormat == FormatSSML {
+		return "<speak>" + text + "</speak>"
+	}
+	return text
+}
+
+// RenderExpression returns the expression with each field marked the way its part of the
+// description is. Markdown has no markup per field and shows the expression as code,
+// SSML spells it out character by character.
+func (self *descriptor) RenderExpression() (string, error) {
+	entity, err := parse(self)
+	if err != nil {
+		return "", err
+	}
+	if self.Options.OutputFormat == FormatMarkdown {
+		return "`" + strings.Replace(self.Expression, "`", "", -1) + "`", nil
+	} else if self.Options.OutputFormat == FormatSSML {
+		return self.wrapDocument(`<say-as interpret-as="characters">` + html.EscapeString(self.Expression) + "</say-as>"), nil
+	}
+
+	fieldList := make([]int, 0)
+	for field := range entity.Spans {
+		fieldList = append(fieldList, field)
+	}
+	sort.Ints(fieldList)
+
+	var builder strings.Builder
+	end := 0
+	for _, field := range fieldList {
+		span := entity.Spans[field]
+		builder.WriteString(self.markPart(self.Expression[end:span.Start], nil))
+		builder.WriteString(self.markPart(self.Expression[span.Start:span.End], []int{field}))
+		end = span.End
+	}
+	builder.WriteString(self.markPart(self.Expression[end:], nil))
+	return builder.String(), nil
+}
+
+// renderPart marks text generated from the fields, the separator and spaces around it
+// stay outside the markup. Text without fields is only escaped.
+func (self *descriptor) renderPart(text string, fields []int) string {
+	prefix := segmentPrefixRegexp.FindString(text)
+	body := strings.TrimPrefix(text, prefix)
+	suffix := segmentSuffixRegexp.FindString(body)
+	body = strings.TrimSuffix(body, suffix)
+	if len(fields) == 0 || body == "" {
+		return self.markPart(text, nil)
+	}
+	return self.markPart(prefix, nil) + self.markPart(body, fields) + self.markPart(suffix, nil)
+}
+
+// markPart marks text generated from the fields as a whole
+func (self *descriptor) markPart(text string, fields []int) string {
+	switch self.Options.OutputFormat {
+	case FormatHTML:
+		if len(fields) == 0 {
+			return html.EscapeString(text)
+		}
+		nameList := make([]string, 0)
+		for _, field := range fields {
+			nameList = append(nameList, FieldName(field))
+		}
+		return `<span data-field="` + html.EscapeString(strings.Join(nameList, " ")) + `">` +
+			html.EscapeString(text) + "</span>"
+
+	case FormatMarkdown:
+		if len(fields) == 0 {
+			return markdownEscaper.Replace(text)
+		}
+		return "*" + markdownEscaper.Replace(text) + "*"
+
+	case FormatANSI:
+		if len(fields) == 0 {
+			return text
+		}
+		//a phrase combining fields takes the color of the first one
+		return "\x1b[" + fieldColorList[fields[0]] + "m" + text + "\x1b[0m"
+
+	case FormatSSML:
+		text = html.EscapeString(text)
+		return ssmlMarkRegexp.ReplaceAllStringFunc(text, func(mark string) string {
+			match := ssmlMarkRegexp.FindStringSubmatch(mark)
+			return "<say-as " + ssmlSayAsList[match[1]] + ">" + match[2] + "</say-as>"
+		})
+
+	default:
+		return text
+	}
+}
+
+// ssmlMark marks text a speech engine should say as a time or a date of the kind.
+// Only SSML output is marked.
+func (self *descriptor) ssmlMark(kind, text string) string {
+	if self.Options.OutputFormat != FormatSSML {
+		return text
+	}
+	return "\uE003" + kind + "\uE004" + text + "\uE005"
+}
+
+// stripSSMLMarks leaves the text of the marks
+func stripSSMLMarks(text string) string {
+	return ssmlMarkRegexp.ReplaceAllString(text, "$2")
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"testing"
+	"time"
+)
+
+func TestDescriptor_GetDescription_format(t *testing.T) {
+	testList := []struct {
+		language int
+		format   int
+		cron     string
+		expected string
+	}{
+		{locale.EN_US, FormatHTML, "*/5 9-17 * * MON-FRI",
+			`<span data-field="minutes">Every 5 minutes</span>, <span data-field="hours">between 09:00 AM and 05:55 PM</span> <span data-field="dayOfWeek">on weekdays</span>`},
+		{locale.EN_US, FormatHTML, "0 15 10 ? * 6L",
+			`<span data-field="seconds minutes hours">At 10:15 AM</span>, <span data-field="dayOfWeek">on the last Saturday of the month</span>`},
+		{locale.EN_US, FormatMarkdown, "0 12 ? 3 WED",
+			`*At noon*, *only on Wednesday*, *only in March*`},
+		{locale.ZH_CN, FormatMarkdown, "0 12 ? 3 WED",
+			`*在中午*，*仅在星期三*，*仅在三月*`},
+		{locale.EN_US, FormatANSI, "*/5 * * 3 *",
+			"\x1b[32mEvery 5 minutes\x1b[0m, \x1b[36monly in March\x1b[0m"},
+		{locale.EN_US, FormatText, "*/5 * * 3 *",
+			"Every 5 minutes, only in March"},
+		{locale.EN_US, FormatSSML, "0 15 10 ? * * 2030",
+			`<speak>At <say-as interpret-as="time" format="hms12">10:15 AM</say-as>, only in <say-as interpret-as="date" format="y">2030</say-as></speak>`},
+		{locale.IT_IT, FormatSSML, "0 0 L * ?",
+			`<speak>A mezzanotte, l&#39;ultimo giorno del mese</speak>`},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		opts.OutputFormat = val.format
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.expected {
+			t.Errorf("%s (%d, %d): expected %q, got %q", val.cron, val.language, val.format, val.expected, desc)
+		}
+	}
+}
+
+func TestDescriptor_GetDescription_ssml(t *testing.T) {
+	ref := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	testList := []struct {
+		language  int
+		speakable bool
+		examples  int
+		cron      string
+		expected  string
+	}{
+		{locale.EN_US, true, 0, "*/5 9-17 * * *",
+			`<speak>Every five minutes, between <say-as interpret-as="time" format="hms12">09:00 AM</say-as> and <say-as interpret-as="time" format="hms12">05:55 PM</say-as></speak>`},
+		{locale.EN_US, false, 2, "0 15 10 L * ?",
+			`<speak>At <say-as interpret-as="time" format="hms12">10:15 AM</say-as>, on the last day of the month, e.g. ` +
+				`Tuesday <say-as interpret-as="date" format="md">03-31</say-as> <say-as interpret-as="time" format="hms12">10:15 AM</say-as>, ` +
+				`Thursday <say-as interpret-as="date" format="md">04-30</say-as> <say-as interpret-as="time" format="hms12">10:15 AM</say-as></speak>`},
+		{locale.EN_US, false, 1, "0 0 12 1 1 ? 2029",
+			`<speak>At noon, on the 1st of the month, only in January, only in <say-as interpret-as="date" format="y">2029</say-as>, e.g. ` +
+				`Monday <say-as interpret-as="date" format="ymd">2029-01-01</say-as> <say-as interpret-as="time" format="hms12">12:00 PM</say-as></speak>`},
+		{locale.AR_SA, false, 0, "0 15 10 * * ? 2030",
+			"<speak>في الساعة <say-as interpret-as=\"time\" format=\"hms12\">\u206610:15 AM\u2069</say-as>، فقط في عام <say-as interpret-as=\"date\" format=\"y\">\u20662030\u2069</say-as></speak>"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		opts.OutputFormat = FormatSSML
+		opts.Speakable = val.speakable
+		opts.ExampleCount = val.examples
+		opts.ReferenceTime = ref
+		if desc := NewDescriptor(val.cron, opts).GetDescription(); desc != val.expected {
+			t.Errorf("%s (%d): expected %q, got %q", val.cron, val.language, val.expected, desc)
+		}
+	}
+
+	//the segments are plain text whatever the output format
+	opts := NewDefaultOptions()
+	opts.OutputFormat = FormatSSML
+	segments, err := NewDescriptor("0 15 10 * * ? 2030", opts).GetSegments()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text := joinSegments(segments, ""); text != "At 10:15 AM, only in 2030" {
+		t.Errorf("expected plain segments, got %q", text)
+	}
+}
+
+func TestDescriptor_RenderExpression(t *testing.T) {
+	testList := []struct {
+		format   int
+		cron     string
+		expected string
+	}{
+		{FormatHTML, "*/5 9-17 * * MON-FRI",
+			`<span data-field="minutes">*/5</span> <span data-field="hours">9-17</span> <span data-field="dayOfMonth">*</span> <span data-field="month">*</span> <span data-field="dayOfWeek">MON-FRI</span>`},
+		{FormatANSI, "0 12 ? 3 WED",
+			"\x1b[32m0\x1b[0m \x1b[33m12\x1b[0m \x1b[34m?\x1b[0m \x1b[36m3\x1b[0m \x1b[31mWED\x1b[0m"},
+		{FormatMarkdown, "*/5 * * * *", "`*/5 * * * *`"},
+		{FormatText, "*/5 * * * *", "*/5 * * * *"},
+		{FormatSSML, "*/5 * * * *", `<speak><say-as interpret-as="characters">*/5 * * * *</say-as></speak>`},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.OutputFormat = val.format
+		expression, err := NewDescriptor(val.cron, opts).RenderExpression()
+		if err != nil {
+			t.Fatalf("%s: %v", val.cron, err)
+		}
+		if expression != val.expected {
+			t.Errorf("%s (%d): expected %q, got %q", val.cron, val.format, val.expected, expression)
+		}
+	}
+}
+
+func TestDescriptor_render_escape(t *testing.T) {
+	segments := []Segment{
+		{Text: `<b>"x" & 'y'</b>`, Fields: []int{FieldMinutes}},
+		{Text: ", *only* [z]", Fields: []int{FieldMonth}},
+	}
+	testList := []struct {
+		format   int
+		expected string
+	}{
+		{FormatHTML, `<span data-field="minutes">&lt;b&gt;&#34;x&#34; &amp; &#39;y&#39;&lt;/b&gt;</span>, <span data-field="month">*only* [z]</span>`},
+		{FormatMarkdown, `*\<b\>"x" & 'y'\</b\>*, *\*only\* \[z\]*`},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.OutputFormat = val.format
+		if text := NewDescriptor("", opts).render(segments); text != val.expected {
+			t.Errorf("format %d: expected %q, got %q", val.format, val.expected, text)
+		}
+	}
+}
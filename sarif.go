@@ -0,0 +1,159 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// SARIF 2.1.0 is what code scanning tools read the results of a linter from. The
+// rules go in the driver of the tool, a result points into the file of expressions
+// by line and column and carries the fix of its diagnostic as a replacement of the
+// whole expression.
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+	Fixes     []sarifFix      `json:"fixes,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndColumn   int `json:"endColumn"`
+}
+
+type sarifFix struct {
+	Description     sarifMessage          `json:"description"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion  `json:"deletedRegion"`
+	InsertedContent sarifMessage `json:"insertedContent"`
+}
+
+// writeLintSARIF writes the diagnostics as a SARIF log of a single run, the results of
+// expressions given as arguments have no location
+func writeLintSARIF(w io.Writer, targetList []lintTarget) {
+	driver := sarifDriver{Name: "cron-descriptor", Rules: make([]sarifRule, 0)}
+	ruleIndexList := make(map[string]int)
+	for i, rule := range LintRules() {
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifConfiguration{Level: SeverityName(rule.Severity)},
+		})
+		ruleIndexList[rule.ID] = i
+	}
+
+	resultList := make([]sarifResult, 0)
+	for _, target := range targetList {
+		artifact := sarifArtifactLocation{URI: target.File}
+		//columns start at 1 and the end column is past the region
+		expressionRegion := sarifRegion{
+			StartLine:   target.Line,
+			StartColumn: target.Column + 1,
+			EndColumn:   target.Column + len(target.Expression) + 1,
+		}
+		for _, diagnostic := range target.Diagnostics {
+			result := sarifResult{
+				RuleID:    diagnostic.Rule,
+				RuleIndex: ruleIndexList[diagnostic.Rule],
+				Level:     SeverityName(diagnostic.Severity),
+				Message:   sarifMessage{Text: diagnostic.Message},
+			}
+			if target.File != "" {
+				result.Locations = []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: artifact,
+					Region: sarifRegion{
+						StartLine:   target.Line,
+						StartColumn: target.Column + diagnostic.Span.Start + 1,
+						EndColumn:   target.Column + diagnostic.Span.End + 1,
+					},
+				}}}
+				if diagnostic.Fix != "" {
+					result.Fixes = []sarifFix{{
+						Description: sarifMessage{Text: diagnostic.Message},
+						ArtifactChanges: []sarifArtifactChange{{
+							ArtifactLocation: artifact,
+							Replacements: []sarifReplacement{{
+								DeletedRegion:   expressionRegion,
+								InsertedContent: sarifMessage{Text: diagnostic.Fix},
+							}},
+						}},
+					}}
+				}
+			}
+			resultList = append(resultList, result)
+		}
+	}
+
+	log := sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: resultList}},
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(log)
+}
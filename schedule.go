@@ -0,0 +1,253 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A schedule evaluates the normalized expression to find the times it fires,
+// i.e. to give examples of its occurrences. The day fields are matched item by
+// item so that the special characters (L, W, #) can be mixed with plain values.
+
+// maxSearchYears bounds the search for an occurrence, the calendar repeats itself
+// every 400 years so an expression that has not fired by then never does
+const maxSearchYears = 400
+
+type schedule struct {
+	secondList []int
+	minuteList []int
+	hourList   []int
+	monthList  []int
+	//nil when the expression runs every year
+	yearSet map[int]bool
+	//nil when the field does not restrict the days
+	dayOfMonthMatchers []func(date time.Time) bool
+	dayOfWeekMatchers  []func(date time.Time) bool
+	//a day matching either day field will do (see dialect.go)
+	eitherDay bool
+}
+
+func newSchedule(entity *cronEntity, opts *options) (*schedule, error) {
+	self := &schedule{eitherDay: daysEitherMatch(entity, opts)}
+	var ok bool
+	if self.secondList, ok = expandField(entity.Seconds, 0, 59); !ok {
+		return nil, errors.New("unsupported seconds")
+	}
+	if self.minuteList, ok = expandField(entity.Minutes, 0, 59); !ok {
+		return nil, errors.New("unsupported minutes")
+	}
+	if self.hourList, ok = expandField(entity.Hours, 0, 23); !ok {
+		return nil, errors.New("unsupported hours")
+	}
+	if self.monthList, ok = expandField(entity.Month, 1, 12); !ok {
+		return nil, errors.New("unsupported month")
+	}
+	if entity.Year != "" && entity.Year != "*" {
+		yearList, ok := yearsOf(entity)
+		if !ok {
+			return nil, errors.New("unsupported year")
+		}
+		self.yearSet = make(map[int]bool)
+		for _, year := range yearList {
+			self.yearSet[year] = true
+		}
+	}
+
+	if entity.DayOfMonth != "*" {
+		for _, item := range strings.Split(entity.DayOfMonth, ",") {
+			matcher, err := dayOfMonthMatcher(item)
+			if err != nil {
+				return nil, err
+			}
+			self.dayOfMonthMatchers = append(self.dayOfMonthMatchers, matcher)
+		}
+	}
+	if entity.DayOfWeek != "*" {
+		for _, item := range strings.Split(entity.DayOfWeek, ",") {
+			matcher, err := dayOfWeekMatcher(item)
+			if err != nil {
+				return nil, err
+			}
+			self.dayOfWeekMatchers = append(self.dayOfWeekMatchers, matcher)
+		}
+	}
+	return self, nil
+}
+
+func dayOfMonthMatcher(item string) (func(date time.Time) bool, error) {
+	if item == "L" {
+		return func(date time.Time) bool {
+			return date.Day() == daysIn(date)
+		}, nil
+
+	} else if item == "LW" || item == "WL" {
+		return func(date time.Time) bool {
+			return date.Day() == nearestWeekday(date, daysIn(date))
+		}, nil
+
+	} else if match := shortNearestWeekdayRegexp.FindStringSubmatch(item); match != nil {
+		day, _ := strconv.Atoi(match[1] + match[2])
+		if day < 1 || day > 31 {
+			return nil, errors.New("error day of month")
+		}
+		return func(date time.Time) bool {
+			//a day past the end of the month has no nearest weekday
+			return day <= daysIn(date) && date.Day() == nearestWeekday(date, day)
+		}, nil
+	}
+
+	dayList, ok := expandField(item, 1, 31)
+	if !ok {
+		return nil, errors.New("unsupported day of month")
+	}
+	daySet := make(map[int]bool)
+	for _, day := range dayList {
+		daySet[day] = true
+	}
+	return func(date time.Time) bool {
+		return daySet[date.Day()]
+	}, nil
+}
+
+func dayOfWeekMatcher(item string) (func(date time.Time) bool, error) {
+	if match := shortNthDayRegexp.FindStringSubmatch(item); match != nil {
+		weekday, _ := strconv.Atoi(match[1])
+		nth, _ := strconv.Atoi(match[2])
+		return func(date time.Time) bool {
+			return int(date.Weekday()) == weekday%7 && (date.Day()-1)/7+1 == nth
+		}, nil
+
+	} else if match := shortLastDayRegexp.FindStringSubmatch(item); match != nil {
+		weekday, _ := strconv.Atoi(match[1])
+		return func(date time.Time) bool {
+			return int(date.Weekday()) == weekday%7 && date.Day()+7 > daysIn(date)
+		}, nil
+	}
+
+	//7 is Sunday too
+	dayList, ok := expandField(item, 0, 7)
+	if !ok {
+		return nil, errors.New("unsupported day of week")
+	}
+	daySet := make(map[int]bool)
+	for _, day := range dayList {
+		daySet[day%7] = true
+	}
+	return func(date time.Time) bool {
+		return daySet[int(date.Weekday())]
+	}, nil
+}
+
+// daysIn returns the number of days of the month of date
+func daysIn(date time.Time) int {
+	return time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nearestWeekday returns the weekday of the month of date nearest to day, without
+// leaving the month (the 1st falling on a Saturday moves to Monday the 3rd)
+func nearestWeekday(date time.Time, day int) int {
+	switch time.Date(date.Year(), date.Month(), day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == daysIn(date) {
+			return day - 2
+		}
+		return day + 1
+	default:
+		return day
+	}
+}
+
+func matchesAny(matchers []func(date time.Time) bool, date time.Time) bool {
+	for _, matcher := range matchers {
+		if matcher(date) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesDay reports whether the expression runs on the day of date
+func (self *schedule) matchesDay(date time.Time) bool {
+	if self.eitherDay {
+		return matchesAny(self.dayOfMonthMatchers, date) || matchesAny(self.dayOfWeekMatchers, date)
+	}
+	if self.dayOfMonthMatchers != nil && !matchesAny(self.dayOfMonthMatchers, date) {
+		return false
+	}
+	return self.dayOfWeekMatchers == nil || matchesAny(self.dayOfWeekMatchers, date)
+}
+
+// next returns the first time the expression fires after the given time, in its location
+func (self *schedule) next(after time.Time) (time.Time, bool) {
+	location := after.Location()
+	firstDay := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, location)
+
+	for year := after.Year(); year <= lastYear && year <= after.Year()+maxSearchYears; year++ {
+		if self.yearSet != nil && !self.yearSet[year] {
+			continue
+		}
+		for _, month := range self.monthList {
+			monthDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, location)
+			for day := 1; day <= daysIn(monthDate); day++ {
+				date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, location)
+				if date.Before(firstDay) || !self.matchesDay(date) {
+					continue
+				}
+				if occurrence, ok := self.nextOnDay(date, after); ok {
+					return occurrence, true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// nextOnDay returns the first time of the day of date the expression fires after the given time
+func (self *schedule) nextOnDay(date time.Time, after time.Time) (time.Time, bool) {
+	for _, hour := range self.hourList {
+		for _, minute := range self.minuteList {
+			for _, second := range self.secondList {
+				occurrence := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, second, 0, date.Location())
+				//a time skipped by a daylight saving change does not happen
+				if occurrence.Hour() != hour || occurrence.Minute() != minute {
+					continue
+				}
+				if occurrence.After(after) {
+					return occurrence, true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// Occurrences returns the next count times the expression fires after the given time,
+// in its location. There are fewer when the expression stops firing.
+func (self *descriptor) Occurrences(after time.Time, count int) ([]time.Time, error) {
+	entity, err := parse(self)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := newSchedule(entity, self.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrenceList := make([]time.Time, 0)
+	for len(occurrenceList) < count {
+		occurrence, ok := schedule.next(after)
+		if !ok {
+			break
+		}
+		occurrenceList = append(occurrenceList, occurrence)
+		after = occurrence
+	}
+	return occurrenceList, nil
+}
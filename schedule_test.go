@@ -0,0 +1,96 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDescriptor_Occurrences(t *testing.T) {
+	after := time.Date(2025, time.October, 20, 12, 0, 0, 0, time.UTC)
+	testList := []struct {
+		cron     string
+		expected []string
+	}{
+		{"*/20 * * * *", []string{"2025-10-20 12:20:00", "2025-10-20 12:40:00", "2025-10-20 13:00:00"}},
+		{"0 15 10 ? * 6L", []string{"2025-10-25 10:15:00", "2025-11-29 10:15:00", "2025-12-27 10:15:00"}},
+		{"0 0 12 LW * ?", []string{"2025-10-31 12:00:00", "2025-11-28 12:00:00", "2025-12-31 12:00:00"}},
+		{"0 0 9 1W * ?", []string{"2025-11-03 09:00:00", "2025-12-01 09:00:00", "2026-01-01 09:00:00"}},
+		{"0 0 9 ? * 1#2", []string{"2025-11-10 09:00:00", "2025-12-08 09:00:00", "2026-01-12 09:00:00"}},
+		{"0 0 29 2 *", []string{"2028-02-29 00:00:00", "2032-02-29 00:00:00", "2036-02-29 00:00:00"}},
+		{"0 0 0 1 1 ? 2026/5", []string{"2026-01-01 00:00:00", "2031-01-01 00:00:00", "2036-01-01 00:00:00"}},
+		{"0 0 1 1 ? 2020", []string{}},
+		{"0 0 30 2 *", []string{}},
+	}
+
+	for _, val := range testList {
+		occurrenceList, err := NewDescriptor(val.cron, NewDefaultOptions()).Occurrences(after, 3)
+		if err != nil {
+			t.Fatalf("%s: %v", val.cron, err)
+		}
+		if len(occurrenceList) != len(val.expected) {
+			t.Errorf("%s: expected %v, got %v", val.cron, val.expected, occurrenceList)
+			continue
+		}
+		for i, occurrence := range occurrenceList {
+			if text := occurrence.Format("2006-01-02 15:04:05"); text != val.expected[i] {
+				t.Errorf("%s: expected %v, got %v", val.cron, val.expected, occurrenceList)
+				break
+			}
+		}
+	}
+}
+
+func TestDescriptor_Occurrences_timeZone(t *testing.T) {
+	//clocks in New York skip from 02:00 to 03:00 on 2026-03-08
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	after := time.Date(2026, time.March, 7, 12, 0, 0, 0, location)
+	occurrenceList, err := NewDescriptor("30 2 * * *", NewDefaultOptions()).Occurrences(after, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"2026-03-09 02:30 EDT", "2026-03-10 02:30 EDT"}
+	for i, occurrence := range occurrenceList {
+		if text := occurrence.Format("2006-01-02 15:04 MST"); text != expected[i] {
+			t.Errorf("expected %v, got %v", expected, occurrenceList)
+		}
+	}
+}
+
+func TestDescriptor_Occurrences_dialect(t *testing.T) {
+	after := time.Date(2025, time.October, 20, 12, 0, 0, 0, time.UTC)
+	testList := []struct {
+		dialect  int
+		cron     string
+		expected []string
+	}{
+		{DialectAnd, "0 0 1 * MON", []string{"2025-12-01 00:00:00", "2026-06-01 00:00:00", "2027-02-01 00:00:00"}},
+		{DialectVixie, "0 0 1 * MON", []string{"2025-10-27 00:00:00", "2025-11-01 00:00:00", "2025-11-03 00:00:00"}},
+		//a field starting with a star does not restrict the days, both must match
+		{DialectVixie, "0 0 */2 * MON", []string{"2025-10-27 00:00:00", "2025-11-03 00:00:00", "2025-11-17 00:00:00"}},
+		{DialectVixie, "0 0 * * MON", []string{"2025-10-27 00:00:00", "2025-11-03 00:00:00", "2025-11-10 00:00:00"}},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Dialect = val.dialect
+		occurrenceList, err := NewDescriptor(val.cron, opts).Occurrences(after, 3)
+		if err != nil {
+			t.Fatalf("%s: %v", val.cron, err)
+		}
+		for i, occurrence := range occurrenceList {
+			if text := occurrence.Format("2006-01-02 15:04:05"); text != val.expected[i] {
+				t.Errorf("%s (%d): expected %v, got %v", val.cron, val.dialect, val.expected, occurrenceList)
+				break
+			}
+		}
+	}
+
+	opts := NewDefaultOptions()
+	opts.Dialect = DialectQuartz
+	if _, err := NewDescriptor("0 0 1 * MON", opts).Occurrences(after, 3); err == nil {
+		t.Error("expected an error for both day fields under the Quartz dialect")
+	}
+}
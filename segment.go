@@ -0,0 +1,96 @@
+package main
+
+import (
+	"strings"
+)
+
+// segmentBoundary keeps the segments apart while the whole description is transformed,
+// it is a private use rune like the name references
+const segmentBoundary = "\uE002"
+
+// Span is the byte range [Start, End) of the expression a segment was generated from
+type Span struct {
+	Start int
+	End   int
+}
+
+// Segment is a part of the description together with where it came from.
+// Fields lists the fields of the expression the text describes, more than one
+// when a phrase such as "At 10:15 AM" combines them, and Span covers their tokens.
+type Segment struct {
+	Text   string
+	Fields []int
+	Span   Span
+	Kind   int
+}
+
+func newSegment(entity *cronEntity, kind int, text string, fields ...int) Segment {
+	segment := Segment{
+		Text:   text,
+		Fields: make([]int, 0),
+		Kind:   kind,
+	}
+	for _, field := range fields {
+		span, ok := entity.Spans[field]
+		if !ok {
+			continue
+		}
+		if len(segment.Fields) == 0 {
+			segment.Span = span
+		} else {
+			if span.Start < segment.Span.Start {
+				segment.Span.Start = span.Start
+			}
+			if span.End > segment.Span.End {
+				segment.Span.End = span.End
+			}
+		}
+		segment.Fields = append(segment.Fields, field)
+	}
+	return segment
+}
+
+func newFieldSegment(entity *cronEntity, field int, text string) Segment {
+	return newSegment(entity, segmentKind(entity.field(field)), text, field)
+}
+
+// segmentKind tells how a field of the normalized expression is written
+func segmentKind(expression string) int {
+	if expression == "*" {
+		return SegmentAll
+	} else if strings.Contains(expression, "/") {
+		return SegmentInterval
+	} else if strings.Contains(expression, ",") {
+		return SegmentList
+	} else if strings.Contains(expression, "-") {
+		return SegmentRange
+	} else if strings.ContainsAny(expression, "LW#") {
+		return SegmentSpecial
+	}
+	return SegmentSingle
+}
+
+func joinSegments(segments []Segment, separator string) string {
+	textList := make([]string, 0)
+	for _, segment := range segments {
+		textList = append(textList, segment.Text)
+	}
+	return strings.Join(textList, separator)
+}
+
+// transformSegments runs fnTransform over the whole description at once, so transforms
+// such as casing see the sentence rather than its pieces, and drops the segments left empty
+func transformSegments(segments []Segment, fnTransform func(description string) string) []Segment {
+	textList := strings.Split(fnTransform(joinSegments(segments, segmentBoundary)), segmentBoundary)
+
+	transformedSegments := make([]Segment, 0)
+	for i, segment := range segments {
+		if i < len(textList) {
+			segment.Text = textList[i]
+		}
+		if segment.Text != "" {
+			transformedSegments = append(transformedSegments, segment)
+		}
+	}
+	return transformedSegments
+}
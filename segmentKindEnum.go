@@ -0,0 +1,15 @@
+package main
+
+const (
+	//SegmentKindEnum
+	unuseSegment = iota
+	SegmentAll
+	SegmentSingle
+	SegmentInterval
+	SegmentRange
+	SegmentList
+	SegmentSpecial
+	SegmentExample
+	SegmentInvalid
+	SegmentWarning
+)
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"reflect"
+	"testing"
+)
+
+func TestDescriptor_GetSegments(t *testing.T) {
+	testList := []struct {
+		cron     string
+		expected []Segment
+	}{
+		{
+			cron: "0 15 10 ? * 6L 2002-2005",
+			expected: []Segment{
+				{Text: "At 10:15 AM", Fields: []int{FieldSeconds, FieldMinutes, FieldHours}, Span: Span{0, 7}, Kind: SegmentSingle},
+				{Text: ", on the last Saturday of the month", Fields: []int{FieldDayOfWeek}, Span: Span{12, 14}, Kind: SegmentSpecial},
+				{Text: ", 2002 through 2005", Fields: []int{FieldYear}, Span: Span{15, 24}, Kind: SegmentRange},
+			},
+		},
+		{
+			cron: "0 0 1 */3 * 2030",
+			expected: []Segment{
+				{Text: "At midnight", Fields: []int{FieldMinutes, FieldHours}, Span: Span{0, 3}, Kind: SegmentSingle},
+				{Text: ", on the 1st of the month", Fields: []int{FieldDayOfMonth}, Span: Span{4, 5}, Kind: SegmentSingle},
+				{Text: ", every 3 months", Fields: []int{FieldMonth}, Span: Span{6, 9}, Kind: SegmentInterval},
+				{Text: ", only in 2030", Fields: []int{FieldYear}, Span: Span{12, 16}, Kind: SegmentSingle},
+			},
+		},
+		{
+			cron: "0/2 * * * * ?",
+			expected: []Segment{
+				{Text: "Every 2 seconds", Fields: []int{FieldSeconds}, Span: Span{0, 3}, Kind: SegmentInterval},
+			},
+		},
+		{
+			cron: "*/5 9-17 * JAN,MAR MON-FRI",
+			expected: []Segment{
+				{Text: "Every 5 minutes", Fields: []int{FieldMinutes}, Span: Span{0, 3}, Kind: SegmentInterval},
+				{Text: ", between 09:00 AM and 05:55 PM", Fields: []int{FieldHours}, Span: Span{4, 8}, Kind: SegmentRange},
+				{Text: " on weekdays", Fields: []int{FieldDayOfWeek}, Span: Span{19, 26}, Kind: SegmentRange},
+				{Text: ", only in January and March", Fields: []int{FieldMonth}, Span: Span{11, 18}, Kind: SegmentList},
+			},
+		},
+	}
+
+	for _, val := range testList {
+		segments, err := NewDescriptor(val.cron, NewDefaultOptions()).GetSegments()
+		if err != nil {
+			t.Fatalf("%s: %v", val.cron, err)
+		}
+		if !reflect.DeepEqual(segments, val.expected) {
+			t.Errorf("%s:\nexpected %+v\ngot      %+v", val.cron, val.expected, segments)
+		}
+	}
+}
+
+func TestDescriptor_GetSegments_joined(t *testing.T) {
+	for _, language := range goldenLocaleList {
+		opts := NewDefaultOptions()
+		opts.Language = language
+		opts.NativeDigits = true
+
+		for _, cron := range goldenCronList {
+			desc := NewDescriptor(cron, opts)
+			segments, err := desc.GetSegments()
+			if err != nil {
+				t.Fatalf("%s: %v", cron, err)
+			}
+			if joined, description := joinSegments(segments, ""), desc.GetDescription(); joined != description {
+				t.Errorf("%s (locale %d): segments join to %q, description is %q", cron, language, joined, description)
+			}
+			for _, segment := range segments {
+				if segment.Text == "" || len(segment.Fields) == 0 || segment.Kind == unuseSegment {
+					t.Errorf("%s (locale %d): incomplete segment %+v", cron, language, segment)
+				}
+				if segment.Span.Start >= segment.Span.End || segment.Span.End > len(cron) {
+					t.Errorf("%s (locale %d): span %v out of the expression", cron, language, segment.Span)
+				}
+			}
+		}
+	}
+
+	opts := NewDefaultOptions()
+	opts.Language = locale.DE_DE
+	opts.DescriptionType = DescDayOfWeek
+	segments, err := NewDescriptor("0 0 12 ? * MON", opts).GetSegments()
+	if err != nil || len(segments) != 1 || segments[0].Kind != SegmentSingle || segments[0].Span != (Span{11, 14}) {
+		t.Errorf("day of week description: unexpected segments %+v, %v", segments, err)
+	}
+}
@@ -0,0 +1,9 @@
+package main
+
+const (
+	//SeverityEnum, from the least severe
+	unuseSeverity = iota
+	SeverityNote
+	SeverityWarning
+	SeverityError
+)
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/message"
+)
+
+// With the Speakable option descriptions are worded to be read aloud by a screen
+// reader or a voice assistant: numbers are written out in the words of the locale,
+// times of day are said the way people say them (i.e. "ten fifteen p.m.") and the
+// long style is used whatever the DescriptionStyle, abbreviations and dashes read
+// badly. Locales without number words keep their digits, Arabic and Hebrew for now
+// (see locale/number.go).
+
+// speakNumber returns the words of a number when the description is speakable
+func (self *descriptor) speakNumber(s string) string {
+	if !self.Options.Speakable {
+		return s
+	}
+	if number, err := strconv.Atoi(s); err == nil {
+		if word, ok := locale.NumberWord(self.Options.Language, number, locale.Masculine); ok {
+			return word
+		}
+	}
+	return s
+}
+
+// timeReference puts a spoken time of day (hour 0 to 24) into the description as a
+// reference, like the day and month names it is only said once the message it ends up in
+// is known, which decides the case of its numbers (i.e. "с семи часов утра")
+func (self *descriptor) timeReference(hour, minute int, secondsExp string) string {
+	if secondsExp == "" {
+		return fmt.Sprintf("\uE000t%d.%d\uE001", hour, minute)
+	}
+	return fmt.Sprintf("\uE000t%d.%d.%s\uE001", hour, minute, secondsExp)
+}
+
+// resolveTime says the time of day of a reference in the form
+func (self *descriptor) resolveTime(reference string, form locale.Form) string {
+	partList := strings.Split(reference, ".")
+	hour, _ := strconv.Atoi(partList[0])
+	minute, _ := strconv.Atoi(partList[1])
+	secondsExp := ""
+	if len(partList) > 2 {
+		secondsExp = partList[2]
+	}
+	spoken, _ := self.spokenTime(hour, minute, secondsExp, form)
+	return spoken
+}
+
+// spokenTime says a time of day (hour 0 to 24) in the form, ok is false when the locale
+// has no words for it. The messages agree with the hour (i.e. "une heure", "deux heures")
+// and the 12-hour clock says the part of the day the locale says it with.
+func (self *descriptor) spokenTime(hour, minute int, secondsExp string, form locale.Form) (string, bool) {
+	language := self.Options.Language
+	periodKey := ""
+	if !self.Options.Use24hourTimeFormat {
+		if key, ok := locale.DayPeriodKey(language, hour%24); ok {
+			periodKey = key
+		} else if hour >= 12 {
+			periodKey = "%s p.m."
+		} else {
+			periodKey = "%s a.m."
+		}
+		//midnight is twelve on a 12-hour clock
+		if hour > 12 {
+			hour -= 12
+		} else if hour == 0 {
+			hour = 12
+		}
+	}
+	hourWord, ok := locale.HourWord(language, hour, locale.CountGender(language, "%s o'clock"), form)
+	if !ok {
+		return "", false
+	}
+	minuteWord, _ := locale.InflectedNumberWord(language, minute, locale.Masculine, form)
+
+	//the variant of the message for the plural form of the hour, then for the form
+	fnKey := func(key string) string {
+		key = self.pluralKey(key, hour)
+		if formKey := locale.FormKey(key, form); locale.HasMessage(language, formKey) {
+			return formKey
+		}
+		return key
+	}
+
+	spoken := ""
+	if secondsExp != "" {
+		seconds, _ := strconv.Atoi(secondsExp)
+		secondsWord, _ := locale.InflectedNumberWord(language, seconds, locale.Masculine, form)
+		spoken = self.Printer.Sprintf(message.Key(fnKey("%s:%s:%s spoken"), "%s %s and %s seconds"), hourWord, minuteWord, secondsWord)
+	} else if minute == 0 {
+		spoken = self.Printer.Sprintf(fnKey("%s o'clock"), hourWord)
+	} else if minute < 10 {
+		spoken = self.Printer.Sprintf(message.Key(fnKey("%s:0%s spoken"), "%s oh %s"), hourWord, minuteWord)
+	} else {
+		spoken = self.Printer.Sprintf(message.Key(fnKey("%s:%s spoken"), "%s %s"), hourWord, minuteWord)
+	}
+
+	if periodKey != "" {
+		return self.Printer.Sprintf(periodKey, spoken), true
+	}
+	return spoken, true
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"errors"
+	"time"
+)
+
+// The statistics of an expression answer capacity planning questions over a window of
+// time: how many runs a day, a week or a month, how far apart and at which hour most.
+// The runs are not listed one by one: a whole day of the window fires at the times of
+// day of the expression, counted once, and only the days cut by the window or by a
+// daylight saving change are walked run by run.
+
+// PeriodCount is the number of runs in the calendar periods (days, weeks from Monday or
+// months) lying wholly within the window, Periods is 0 when there is none
+type PeriodCount struct {
+	Periods int
+	Min     int64
+	Max     int64
+	Mean    float64
+}
+
+// Stats is how often an expression runs within a window of time. The intervals are
+// between consecutive runs, 0 with fewer than 2 runs, and BusiestHour is -1 without runs.
+type Stats struct {
+	Count            int64
+	PerDay           PeriodCount
+	PerWeek          PeriodCount
+	PerMonth         PeriodCount
+	MinInterval      time.Duration
+	MaxInterval      time.Duration
+	MeanInterval     time.Duration
+	BusiestHour      int
+	BusiestHourCount int64
+}
+
+// periodTally counts the runs of the period being walked and adds it to its PeriodCount
+// once the walk leaves it
+type periodTally struct {
+	fnStart func(day time.Time) time.Time
+	fnNext  func(start time.Time) time.Time
+	start   time.Time
+	count   int64
+	total   int64
+	result  *PeriodCount
+}
+
+func (self *periodTally) add(day time.Time, count int64, from, to time.Time) {
+	if start := self.fnStart(day); !start.Equal(self.start) {
+		self.flush(from, to)
+		self.start, self.count = start, 0
+	}
+	self.count += count
+}
+
+func (self *periodTally) flush(from, to time.Time) {
+	if self.start.IsZero() || self.start.Before(from) || self.fnNext(self.start).After(to) {
+		return
+	}
+	if self.result.Periods == 0 || self.count < self.result.Min {
+		self.result.Min = self.count
+	}
+	if self.count > self.result.Max {
+		self.result.Max = self.count
+	}
+	self.result.Periods++
+	self.total += self.count
+	self.result.Mean = float64(self.total) / float64(self.result.Periods)
+}
+
+// Stats counts the runs of the expression from the given time over the window, in the
+// time zone of the options or else the location of from
+func (self *descriptor) Stats(from time.Time, window time.Duration) (*Stats, error) {
+	if window <= 0 {
+		return nil, errors.New("window is empty")
+	}
+	entity, err := parse(self)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := newSchedule(entity, self.Options)
+	if err != nil {
+		return nil, err
+	}
+	if self.Options.TimeZone != nil {
+		from = from.In(self.Options.TimeZone)
+	}
+	return schedule.stats(from, from.Add(window)), nil
+}
+
+// stats walks the days of the window [from, to), see Stats
+func (self *schedule) stats(from, to time.Time) *Stats {
+	result := &Stats{BusiestHour: -1}
+	location := from.Location()
+
+	//what a whole day of 24 hours adds up to
+	timeList := self.timesOfDay()
+	var hourCountList [24]int64
+	var dayMinGap, dayMaxGap int64 = -1, 0
+	for i, seconds := range timeList {
+		hourCountList[seconds/3600]++
+		if i > 0 {
+			gap := seconds - timeList[i-1]
+			if dayMinGap < 0 || gap < dayMinGap {
+				dayMinGap = gap
+			}
+			if gap > dayMaxGap {
+				dayMaxGap = gap
+			}
+		}
+	}
+
+	monthSet := make(map[int]bool)
+	for _, month := range self.monthList {
+		monthSet[month] = true
+	}
+	tallyList := []*periodTally{
+		{
+			fnStart: func(day time.Time) time.Time { return day },
+			fnNext:  func(start time.Time) time.Time { return start.AddDate(0, 0, 1) },
+			result:  &result.PerDay,
+		},
+		{
+			fnStart: func(day time.Time) time.Time { return day.AddDate(0, 0, -(int(day.Weekday())+6)%7) },
+			fnNext:  func(start time.Time) time.Time { return start.AddDate(0, 0, 7) },
+			result:  &result.PerWeek,
+		},
+		{
+			fnStart: func(day time.Time) time.Time { return day.AddDate(0, 0, 1-day.Day()) },
+			fnNext:  func(start time.Time) time.Time { return start.AddDate(0, 1, 0) },
+			result:  &result.PerMonth,
+		},
+	}
+
+	fnInterval := func(interval time.Duration) {
+		if result.MinInterval == 0 || interval < result.MinInterval {
+			result.MinInterval = interval
+		}
+		if interval > result.MaxInterval {
+			result.MaxInterval = interval
+		}
+	}
+	var first, last time.Time
+	var hourTotalList [24]int64
+
+	for day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, location); day.Before(to); day = day.AddDate(0, 0, 1) {
+		count := int64(0)
+		if (self.yearSet == nil || self.yearSet[day.Year()]) && monthSet[int(day.Month())] && self.matchesDay(day) {
+			nextDay := day.AddDate(0, 0, 1)
+			if !day.Before(from) && !nextDay.After(to) && nextDay.Sub(day) == 24*time.Hour && len(timeList) > 0 {
+				//a whole day without a daylight saving change
+				dayFirst := day.Add(time.Duration(timeList[0]) * time.Second)
+				if !last.IsZero() {
+					fnInterval(dayFirst.Sub(last))
+				} else {
+					first = dayFirst
+				}
+				if dayMinGap >= 0 {
+					fnInterval(time.Duration(dayMinGap) * time.Second)
+					fnInterval(time.Duration(dayMaxGap) * time.Second)
+				}
+				last = day.Add(time.Duration(timeList[len(timeList)-1]) * time.Second)
+				count = int64(len(timeList))
+				for hour, hourCount := range hourCountList {
+					hourTotalList[hour] += hourCount
+				}
+			} else {
+				for _, seconds := range timeList {
+					hour, minute, second := int(seconds/3600), int(seconds/60%60), int(seconds%60)
+					occurrence := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, location)
+					//a time skipped by a daylight saving change does not happen
+					if occurrence.Hour() != hour || occurrence.Minute() != minute || occurrence.Before(from) || !occurrence.Before(to) {
+						continue
+					}
+					if !last.IsZero() {
+						fnInterval(occurrence.Sub(last))
+					} else {
+						first = occurrence
+					}
+					last = occurrence
+					count++
+					hourTotalList[hour]++
+				}
+			}
+		}
+		result.Count += count
+		for _, tally := range tallyList {
+			tally.add(day, count, from, to)
+		}
+	}
+	for _, tally := range tallyList {
+		tally.flush(from, to)
+	}
+
+	if result.Count > 1 {
+		result.MeanInterval = last.Sub(first) / time.Duration(result.Count-1)
+	}
+	for hour, hourTotal := range hourTotalList {
+		if hourTotal > result.BusiestHourCount {
+			result.BusiestHour, result.BusiestHourCount = hour, hourTotal
+		}
+	}
+	return result
+}
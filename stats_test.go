@@ -0,0 +1,83 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDescriptor_Stats(t *testing.T) {
+	testList := []struct {
+		cron     string
+		count    int64
+		perDay   PeriodCount
+		perWeek  PeriodCount
+		interval [3]time.Duration
+		hour     int
+	}{
+		{"0 9 * * 1-5", 261, PeriodCount{365, 0, 1, 261.0 / 365}, PeriodCount{52, 5, 5, 5},
+			[3]time.Duration{24 * time.Hour, 72 * time.Hour, 33*time.Hour + 36*time.Minute}, 9},
+		{"0 */4 * * *", 2190, PeriodCount{365, 6, 6, 6}, PeriodCount{52, 42, 42, 42},
+			[3]time.Duration{4 * time.Hour, 4 * time.Hour, 4 * time.Hour}, 0},
+		{"0 0 30 2 *", 0, PeriodCount{365, 0, 0, 0}, PeriodCount{52, 0, 0, 0}, [3]time.Duration{}, -1},
+	}
+
+	//2024 has 52 weeks from Monday the 1st of January
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for _, val := range testList {
+		stats, err := NewDescriptor(val.cron, NewDefaultOptions()).Stats(from, 365*24*time.Hour)
+		if err != nil {
+			t.Fatalf("%s: %v", val.cron, err)
+		}
+		interval := [3]time.Duration{stats.MinInterval, stats.MaxInterval, stats.MeanInterval.Round(time.Second)}
+		if stats.Count != val.count || stats.PerDay != val.perDay || stats.PerWeek != val.perWeek ||
+			interval != val.interval || stats.BusiestHour != val.hour {
+			t.Errorf("%s: unexpected stats %+v", val.cron, stats)
+		}
+	}
+}
+
+func TestDescriptor_Stats_window(t *testing.T) {
+	//the window starts and ends in the middle of a day
+	from := time.Date(2025, time.March, 3, 12, 0, 0, 0, time.UTC)
+	stats, err := NewDescriptor("0 */4 * * *", NewDefaultOptions()).Stats(from, 48*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Count != 12 || stats.PerDay != (PeriodCount{1, 6, 6, 6}) || stats.PerWeek.Periods != 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestDescriptor_Stats_timeZone(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip(err)
+	}
+	//the clocks go forward at 2:00 on the 30th of March 2025 and back at 3:00 on the 26th of October
+	opts := NewDefaultOptions()
+	opts.TimeZone = berlin
+	stats, err := NewDescriptor("30 2 * * *", opts).Stats(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), 365*24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Count != 364 || stats.MinInterval != 24*time.Hour || stats.MaxInterval != 47*time.Hour {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+
+	stats, err = NewDescriptor("0 */4 * * *", opts).Stats(time.Date(2025, time.October, 25, 0, 0, 0, 0, berlin), 72*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Count != 18 || stats.MinInterval != 4*time.Hour || stats.MaxInterval != 5*time.Hour {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestDescriptor_Stats_error(t *testing.T) {
+	if _, err := NewDescriptor("* * * * *", NewDefaultOptions()).Stats(time.Now(), 0); err == nil {
+		t.Error("expected an error for an empty window")
+	}
+	if _, err := NewDescriptor("* * *", NewDefaultOptions()).Stats(time.Now(), time.Hour); err == nil {
+		t.Error("expected an error for an invalid expression")
+	}
+}
@@ -0,0 +1,351 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Suggestions catch the usual mistakes in an expression that still parses but most
+// likely does not mean what its author meant, i.e. "* 9 * * *" runs sixty times
+// between 9 and 10 rather than once at 9. Each comes with the corrected expression,
+// the tokens of the author are kept but for the one corrected.
+
+// Rules of the suggestions
+const (
+	RuleWildcardSeconds = "wildcard-seconds"
+	RuleWildcardMinutes = "wildcard-minutes"
+	RuleSingleStep      = "single-step"
+	RuleHour24          = "hour-24"
+	RuleWrappingRange   = "wrapping-range"
+	RuleDayNamesInMonth = "day-names-in-day-of-month"
+	RuleQuartzInUnix    = "quartz-in-unix"
+)
+
+var (
+	//the values of the fields but the year, ranges are checked within them
+	fieldBoundList = map[int][2]int{
+		FieldSeconds:    {0, 59},
+		FieldMinutes:    {0, 59},
+		FieldHours:      {0, 23},
+		FieldDayOfMonth: {1, 31},
+		FieldMonth:      {1, 12},
+		FieldDayOfWeek:  {0, 6},
+	}
+
+	dayNameRegexp = regexp.MustCompile(`(?i)\b(SUN|MON|TUE|WED|THU|FRI|SAT)\b`)
+	nameRegexp    = regexp.MustCompile(`[A-Za-z]`)
+)
+
+// Suggestion is a likely fix of a mistake in the expression
+type Suggestion struct {
+	Rule        string
+	Field       int
+	Span        Span
+	Expression  string
+	Explanation string
+}
+
+// Suggest returns the fixes of the mistakes found in the expression, in the order of the
+// fields. The expression only has to split into fields.
+func (self *descriptor) Suggest() ([]Suggestion, error) {
+	if self.Expression == "" {
+		return nil, errors.New("expression is empty")
+	}
+	entity, err := splitFields(strings.Split(self.Expression, " "))
+	if err != nil {
+		return nil, err
+	}
+	entity = normalizeExpression(entity, self.Options)
+
+	suggestionList := make([]Suggestion, 0)
+	if suggestion, ok := self.suggestQuartzInUnix(entity); ok {
+		suggestionList = append(suggestionList, suggestion)
+	}
+	for field := FieldSeconds; field <= FieldYear; field++ {
+		if _, ok := entity.Spans[field]; !ok {
+			continue
+		}
+		for _, fnSuggest := range []func(entity *cronEntity, field int) (Suggestion, bool){
+			self.suggestWildcard,
+			self.suggestSingleStep,
+			self.suggestHour24,
+			self.suggestWrappingRange,
+			self.suggestDayNamesInMonth,
+		} {
+			if suggestion, ok := fnSuggest(entity, field); ok {
+				suggestionList = append(suggestionList, suggestion)
+			}
+		}
+	}
+	return suggestionList, nil
+}
+
+// token returns a field as written in the expression
+func (self *descriptor) token(entity *cronEntity, field int) string {
+	span := entity.Spans[field]
+	return self.Expression[span.Start:span.End]
+}
+
+// isolate keeps a token of the expression left to right inside a right to left explanation
+func (self *descriptor) isolate(token string) string {
+	if !locale.IsRightToLeft(self.Options.Language) {
+		return token
+	}
+	return "\u2066" + token + "\u2069"
+}
+
+// newSuggestion returns the suggestion of a rule rewriting the tokens of the fields
+func (self *descriptor) newSuggestion(entity *cronEntity, rule string, field int, tokenList map[int]string, explanation string) Suggestion {
+	partList := strings.Split(self.Expression, " ")
+	start := 0
+	for i, part := range partList {
+		for tokenField, token := range tokenList {
+			if entity.Spans[tokenField].Start == start {
+				partList[i] = token
+			}
+		}
+		start += len(part) + 1
+	}
+	return Suggestion{
+		Rule:        rule,
+		Field:       field,
+		Span:        entity.Spans[field],
+		Expression:  strings.Join(partList, " "),
+		Explanation: explanation,
+	}
+}
+
+// suggestWildcard finds a field running every second or minute of the times the next field
+// restricts, which mostly means once at the start of them (i.e. * 9 * * *). Seconds and
+// minutes both running every time go back to the start of the hours (i.e. * * 9 * * *).
+func (self *descriptor) suggestWildcard(entity *cronEntity, field int) (Suggestion, bool) {
+	if entity.field(field) != "*" {
+		return Suggestion{}, false
+	}
+	switch field {
+	case FieldSeconds:
+		if entity.Minutes == "*" {
+			if strings.HasPrefix(entity.Hours, "*") {
+				return Suggestion{}, false
+			}
+			explanation := self.Printer.Sprintf("Runs every second of the hours given, use 0 for the seconds and minutes to run once at the start of the hour")
+			return self.newSuggestion(entity, RuleWildcardSeconds, field, map[int]string{FieldSeconds: "0", FieldMinutes: "0"}, explanation), true
+		}
+		if strings.HasPrefix(entity.Minutes, "*") {
+			return Suggestion{}, false
+		}
+		explanation := self.Printer.Sprintf("Runs every second of the minutes given, use 0 to run once at the start of the minute")
+		return self.newSuggestion(entity, RuleWildcardSeconds, field, map[int]string{field: "0"}, explanation), true
+	case FieldMinutes:
+		//the seconds suggestion covers the minutes as well
+		if entity.Seconds == "*" || strings.HasPrefix(entity.Hours, "*") {
+			return Suggestion{}, false
+		}
+		explanation := self.Printer.Sprintf("Runs every minute of the hours given, use 0 to run once at the start of the hour")
+		return self.newSuggestion(entity, RuleWildcardMinutes, field, map[int]string{field: "0"}, explanation), true
+	default:
+		return Suggestion{}, false
+	}
+}
+
+// suggestSingleStep finds steps as long as the field, which match a single value (i.e. */60)
+func (self *descriptor) suggestSingleStep(entity *cronEntity, field int) (Suggestion, bool) {
+	bounds, ok := fieldBoundList[field]
+	if !ok {
+		return Suggestion{}, false
+	}
+	itemList := strings.Split(self.token(entity, field), ",")
+	for i, item := range strings.Split(entity.field(field), ",") {
+		if !strings.Contains(item, "/") || i >= len(itemList) {
+			continue
+		}
+		valueList, ok := expandField(item, bounds[0], bounds[1])
+		if !ok || len(valueList) != 1 {
+			continue
+		}
+		step := itemList[i]
+		itemList[i] = strconv.Itoa(valueList[0])
+		explanation := self.Printer.Sprintf("The step %s only matches %s", self.isolate(step), self.isolate(itemList[i]))
+		return self.newSuggestion(entity, RuleSingleStep, field, map[int]string{field: strings.Join(itemList, ",")}, explanation), true
+	}
+	return Suggestion{}, false
+}
+
+// suggestHour24 finds midnight written as 24, alone or as the bound of a range
+// (i.e. 22-24 for 22-23,0)
+func (self *descriptor) suggestHour24(entity *cronEntity, field int) (Suggestion, bool) {
+	if field != FieldHours {
+		return Suggestion{}, false
+	}
+	itemList := make([]string, 0)
+	seen := make(map[string]bool)
+	found := false
+	for _, item := range strings.Split(self.token(entity, field), ",") {
+		fixedList := []string{item}
+		if item == "24" {
+			fixedList = []string{"0"}
+		} else if strings.HasSuffix(item, "-24") && !strings.Contains(item, "/") {
+			fixedList = []string{strings.TrimSuffix(item, "-24") + "-23", "0"}
+		} else if strings.HasPrefix(item, "24-") && !strings.Contains(item, "/") {
+			fixedList = []string{"0-" + strings.TrimPrefix(item, "24-")}
+		}
+		found = found || fixedList[0] != item
+		for _, fixed := range fixedList {
+			//midnight may already be in the list
+			if !seen[fixed] {
+				seen[fixed] = true
+				itemList = append(itemList, fixed)
+			}
+		}
+	}
+	if !found {
+		return Suggestion{}, false
+	}
+	explanation := self.Printer.Sprintf("Hours go from 0 to 23, midnight is 0")
+	return self.newSuggestion(entity, RuleHour24, field, map[int]string{field: strings.Join(itemList, ",")}, explanation), true
+}
+
+// suggestWrappingRange finds ranges written to wrap around the end of the field, which
+// the ranges of cron do not (i.e. 7-1 for Sunday and Monday, 22-2 for the night)
+func (self *descriptor) suggestWrappingRange(entity *cronEntity, field int) (Suggestion, bool) {
+	bounds, ok := fieldBoundList[field]
+	if !ok {
+		return Suggestion{}, false
+	}
+	itemList := strings.Split(self.token(entity, field), ",")
+	for i, item := range strings.Split(entity.field(field), ",") {
+		rangeParts := strings.SplitN(item, "-", 2)
+		if len(rangeParts) != 2 || strings.ContainsAny(item, "*/") || i >= len(itemList) {
+			continue
+		}
+		first, err := strconv.Atoi(rangeParts[0])
+		if err != nil {
+			continue
+		}
+		last, err := strconv.Atoi(rangeParts[1])
+		if err != nil {
+			continue
+		}
+		if first <= last {
+			continue
+		}
+		if field == FieldDayOfWeek && first == 7 {
+			//7 is Sunday too, 7-1 only needs to start from 0
+			first = 0
+		}
+		if first > bounds[1] || last < bounds[0] {
+			continue
+		}
+
+		withNames := nameRegexp.MatchString(itemList[i])
+		fnValue := func(value int) string {
+			if withNames && field == FieldDayOfWeek {
+				return CronDays[value]
+			} else if withNames && field == FieldMonth {
+				return CronMonths[value]
+			}
+			return strconv.Itoa(value)
+		}
+		fnRange := func(from, to int) string {
+			if from == to {
+				return fnValue(from)
+			}
+			return fnValue(from) + "-" + fnValue(to)
+		}
+
+		original := itemList[i]
+		if first <= last {
+			itemList[i] = fnRange(first, last)
+		} else {
+			itemList[i] = fnRange(first, bounds[1]) + "," + fnRange(bounds[0], last)
+		}
+		explanation := self.Printer.Sprintf("Ranges do not wrap around, %s is written %s", self.isolate(original), self.isolate(itemList[i]))
+		return self.newSuggestion(entity, RuleWrappingRange, field, map[int]string{field: strings.Join(itemList, ",")}, explanation), true
+	}
+	return Suggestion{}, false
+}
+
+// suggestDayNamesInMonth finds the days of the week written in the day of month field
+func (self *descriptor) suggestDayNamesInMonth(entity *cronEntity, field int) (Suggestion, bool) {
+	if field != FieldDayOfMonth || entity.DayOfWeek != "*" {
+		return Suggestion{}, false
+	}
+	token := self.token(entity, field)
+	if !dayNameRegexp.MatchString(token) {
+		return Suggestion{}, false
+	}
+	explanation := self.Printer.Sprintf("Day names go in the day of week field")
+	tokenList := map[int]string{
+		FieldDayOfMonth: self.token(entity, FieldDayOfWeek),
+		FieldDayOfWeek:  token,
+	}
+	return self.newSuggestion(entity, RuleDayNamesInMonth, field, tokenList, explanation), true
+}
+
+// suggestQuartzInUnix finds a Quartz expression where the dialect of the options is the Unix
+// one, the expression is rewritten in five fields when it means the same there
+func (self *descriptor) suggestQuartzInUnix(entity *cronEntity) (Suggestion, bool) {
+	if dialectOf(entity, self.Options) != DialectVixie {
+		return Suggestion{}, false
+	}
+	_, hasSeconds := entity.Spans[FieldSeconds]
+	_, hasYear := entity.Spans[FieldYear]
+	if !hasSeconds && !hasYear && !strings.Contains(self.Expression, "?") {
+		return Suggestion{}, false
+	}
+	if hasSeconds && self.token(entity, FieldSeconds) != "0" ||
+		hasYear && self.token(entity, FieldYear) != "*" ||
+		strings.ContainsAny(entity.DayOfMonth+entity.DayOfWeek, "LW#") {
+		return Suggestion{}, false
+	}
+
+	partList := make([]string, 0)
+	for field := FieldMinutes; field <= FieldDayOfWeek; field++ {
+		partList = append(partList, strings.Replace(self.token(entity, field), "?", "*", -1))
+	}
+	//Quartz counts the days of the week from 1 (Sunday), Unix cron from 0
+	dayOfWeek, ok := shiftQuartzDaysOfWeek(partList[len(partList)-1])
+	if !ok {
+		return Suggestion{}, false
+	}
+	partList[len(partList)-1] = dayOfWeek
+	field := FieldDayOfWeek
+	if hasSeconds {
+		field = FieldSeconds
+	}
+	return Suggestion{
+		Rule:        RuleQuartzInUnix,
+		Field:       field,
+		Span:        entity.Spans[field],
+		Expression:  strings.Join(partList, " "),
+		Explanation: self.Printer.Sprintf("This is a Quartz expression, Unix cron has no seconds, years or ?"),
+	}, true
+}
+
+// shiftQuartzDaysOfWeek writes the numbered days of the week of a Quartz field the Unix way,
+// one less (i.e. 2-6 Monday through Friday is 1-5), steps and day names are kept
+func shiftQuartzDaysOfWeek(token string) (string, bool) {
+	itemList := strings.Split(token, ",")
+	for i, item := range itemList {
+		step := ""
+		if j := strings.Index(item, "/"); j >= 0 {
+			item, step = item[:j], item[j:]
+		}
+		boundList := strings.Split(item, "-")
+		for k, bound := range boundList {
+			day, err := strconv.Atoi(bound)
+			if err != nil {
+				continue
+			}
+			if day < 1 || day > 7 {
+				return "", false
+			}
+			boundList[k] = strconv.Itoa(day - 1)
+		}
+		itemList[i] = strings.Join(boundList, "-") + step
+	}
+	return strings.Join(itemList, ","), true
+}
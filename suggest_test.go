@@ -0,0 +1,84 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+	"testing"
+)
+
+func TestDescriptor_Suggest(t *testing.T) {
+	testList := []struct {
+		dialect  int
+		cron     string
+		rule     string
+		expected string
+	}{
+		{DialectAnd, "* 9 * * *", RuleWildcardMinutes, "0 9 * * *"},
+		{DialectAnd, "* 30 9 * * ?", RuleWildcardSeconds, "0 30 9 * * ?"},
+		{DialectAnd, "* * 9 * * *", RuleWildcardSeconds, "0 0 9 * * *"},
+		{DialectAnd, "0 0 * * 7-1", RuleWrappingRange, "0 0 * * 0-1"},
+		{DialectAnd, "0 0 * * FRI-MON", RuleWrappingRange, "0 0 * * FRI-SAT,SUN-MON"},
+		{DialectAnd, "0 22-2 * * *", RuleWrappingRange, "0 22-23,0-2 * * *"},
+		{DialectAnd, "*/60 * * * *", RuleSingleStep, "0 * * * *"},
+		{DialectAnd, "0 9,*/24 * * *", RuleSingleStep, "0 9,0 * * *"},
+		{DialectAnd, "0 24 * * *", RuleHour24, "0 0 * * *"},
+		{DialectAnd, "0 0,24 * * *", RuleHour24, "0 0 * * *"},
+		{DialectAnd, "0 22-24 * * *", RuleHour24, "0 22-23,0 * * *"},
+		{DialectAnd, "0 24,12 * * *", RuleHour24, "0 0,12 * * *"},
+		{DialectAnd, "0 9 MON-FRI * *", RuleDayNamesInMonth, "0 9 * * MON-FRI"},
+		{DialectVixie, "0 0 9 ? * MON-FRI", RuleQuartzInUnix, "0 9 * * MON-FRI"},
+		{DialectVixie, "0 0 9 ? * MON-FRI *", RuleQuartzInUnix, "0 9 * * MON-FRI"},
+		{DialectVixie, "0 0 9 ? * 2-6", RuleQuartzInUnix, "0 9 * * 1-5"},
+		{DialectVixie, "0 0 9 ? * 1,7", RuleQuartzInUnix, "0 9 * * 0,6"},
+		{DialectVixie, "0 0 9 ? * 2/2", RuleQuartzInUnix, "0 9 * * 1/2"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Dialect = val.dialect
+		suggestionList, err := NewDescriptor(val.cron, opts).Suggest()
+		if err != nil {
+			t.Fatalf("%s: %v", val.cron, err)
+		}
+		if len(suggestionList) != 1 || suggestionList[0].Rule != val.rule || suggestionList[0].Expression != val.expected {
+			t.Errorf("%s: expected %s %q, got %+v", val.cron, val.rule, val.expected, suggestionList)
+		}
+	}
+}
+
+func TestDescriptor_Suggest_none(t *testing.T) {
+	//the seconds of a Quartz expression can only be dropped when they are 0
+	for _, cron := range []string{"0 9 * * *", "*/15 * * * *", "0 9 * * 5-7", "30 0 12 ? * MON-FRI", "0 0 12 L * ?"} {
+		opts := NewDefaultOptions()
+		opts.Dialect = DialectVixie
+		suggestionList, err := NewDescriptor(cron, opts).Suggest()
+		if err != nil {
+			t.Fatalf("%s: %v", cron, err)
+		}
+		if len(suggestionList) != 0 {
+			t.Errorf("%s: expected no suggestion, got %+v", cron, suggestionList)
+		}
+	}
+}
+
+func TestDescriptor_Suggest_explanation(t *testing.T) {
+	testList := []struct {
+		language int
+		expected string
+	}{
+		{locale.EN_US, "Ranges do not wrap around, 7-1 is written 0-1"},
+		{locale.DE_DE, "Bereiche laufen nicht über das Ende hinaus, 7-1 wird 0-1 geschrieben"},
+		{locale.HE_IL, "טווחים אינם מתגלגלים, את ⁦7-1⁩ כותבים ⁦0-1⁩"},
+	}
+
+	for _, val := range testList {
+		opts := NewDefaultOptions()
+		opts.Language = val.language
+		suggestionList, err := NewDescriptor("0 0 * * 7-1", opts).Suggest()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(suggestionList) != 1 || suggestionList[0].Explanation != val.expected {
+			t.Errorf("%d: expected %q, got %+v", val.language, val.expected, suggestionList)
+		}
+	}
+}
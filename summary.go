@@ -0,0 +1,115 @@
+package main
+
+import (
+	"cron-descriptor/locale"
+)
+
+// The summaries replace the literal description of some fields by the name a
+// person would give the schedule, i.e. "every quarter hour" for */15 minutes.
+// They only look at the normalized fields and never change what is described.
+
+// summarizeTimeOfDay names the common times of day
+func (self *descriptor) summarizeTimeOfDay(entity *cronEntity) (Segment, bool) {
+	if entity.Seconds != "" {
+		return Segment{}, false
+	}
+
+	fields := []int{FieldSeconds, FieldMinutes, FieldHours}
+	if entity.Hours == "*" && (entity.Minutes == "*/15" || entity.Minutes == "0,15,30,45") {
+		return newSegment(entity, SegmentInterval, self.Printer.Sprintf("every quarter hour"), fields...), true
+
+	} else if entity.Hours == "*" && (entity.Minutes == "*/30" || entity.Minutes == "0,30") {
+		return newSegment(entity, SegmentInterval, self.Printer.Sprintf("every half hour"), fields...), true
+
+	} else if entity.Minutes == "0" && entity.Hours == "9-17" {
+		return newSegment(entity, SegmentRange, self.Printer.Sprintf("every hour during business hours"), fields...), true
+
+	} else if entity.Minutes == "0" && entity.Hours == "0" {
+		return newSegment(entity, SegmentSingle, self.Printer.Sprintf("At midnight"), fields...), true
+
+	} else if entity.Minutes == "0" && entity.Hours == "12" {
+		return newSegment(entity, SegmentSingle, self.Printer.Sprintf("At noon"), fields...), true
+	}
+	return Segment{}, false
+}
+
+// summarizeDaysOfWeek names the working days and the weekend of the locale
+func (self *descriptor) summarizeDaysOfWeek(entity *cronEntity) (Segment, bool) {
+	if entity.DayOfMonth != "*" {
+		return Segment{}, false
+	}
+	dayList, ok := expandField(entity.DayOfWeek, 0, len(WeekDayName)-1)
+	if !ok {
+		return Segment{}, false
+	}
+	days := make(map[int]bool)
+	for _, day := range dayList {
+		days[day] = true
+	}
+
+	weekend := make(map[int]bool)
+	for _, day := range locale.Weekend(self.Options.Language) {
+		weekend[day] = true
+	}
+	workingDays := make(map[int]bool)
+	for day := range WeekDayName {
+		if !weekend[day] {
+			workingDays[day] = true
+		}
+	}
+
+	kind := segmentKind(entity.DayOfWeek)
+	if sameDays(days, workingDays) {
+		return newSegment(entity, kind, self.Printer.Sprintf(" on weekdays"), FieldDayOfWeek), true
+	} else if sameDays(days, weekend) {
+		return newSegment(entity, kind, self.Printer.Sprintf(" on weekends"), FieldDayOfWeek), true
+	}
+	return Segment{}, false
+}
+
+// summarizeDates names the schedules running on the first day of every month, quarter
+// or year, in every year. At midnight the summary takes the time of day in as well,
+// withTime tells so.
+func (self *descriptor) summarizeDates(entity *cronEntity) (segment Segment, withTime bool, ok bool) {
+	//"yearly, every 2 years" says one thing and its opposite
+	if entity.DayOfMonth != "1" || entity.DayOfWeek != "*" || (entity.Year != "" && entity.Year != "*") {
+		return Segment{}, false, false
+	}
+
+	atMidnight := entity.Seconds == "" && entity.Minutes == "0" && entity.Hours == "0"
+	description := ""
+	if entity.Month == "*/3" || entity.Month == "1,4,7,10" {
+		if atMidnight {
+			description = self.Printer.Sprintf("quarterly")
+		} else {
+			description = self.Printer.Sprintf(", on the first day of every quarter")
+		}
+
+	} else if atMidnight && entity.Month == "*" {
+		description = self.Printer.Sprintf("monthly")
+
+	} else if atMidnight && entity.Month == "1" {
+		description = self.Printer.Sprintf("yearly")
+	}
+
+	if description == "" {
+		return Segment{}, false, false
+	}
+	kind := segmentKind(entity.Month)
+	if atMidnight {
+		return newSegment(entity, kind, description, FieldSeconds, FieldMinutes, FieldHours, FieldDayOfMonth, FieldMonth), true, true
+	}
+	return newSegment(entity, kind, description, FieldDayOfMonth, FieldMonth), false, true
+}
+
+func sameDays(days, otherDays map[int]bool) bool {
+	if len(days) != len(otherDays) {
+		return false
+	}
+	for day := range days {
+		if !otherDays[day] {
+			return false
+		}
+	}
+	return true
+}
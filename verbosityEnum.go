@@ -0,0 +1,10 @@
+package main
+
+const (
+	//VerbosityEnum
+	unuseVerbosity = iota
+	VerbosityTerse
+	VerbosityNormal
+	VerbosityVerbose
+	VerbosityExhaustive
+)